@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -31,56 +31,27 @@ type Address struct {
 // GetAddresses returns all addresses for a curstomer, handling pagination
 // customerID is bigcommerce customer id
 func (bc *Client) GetAddresses(customerID int64) ([]Address, error) {
-	cs := []Address{}
-	var csp []Address
-	page := 1
-	more := true
-	var err error
-	var retries int
-	for more {
-		csp, more, err = bc.GetAddressPage(customerID, page)
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				return cs, fmt.Errorf("max retries reached")
-			}
-			break
-		}
-		cs = append(cs, csp...)
-		page++
-	}
-	return cs, err
+	return bc.IterateAddresses(customerID).All()
+}
+
+// IterateAddresses returns an iterator over the addresses of a customer, fetching them page by page
+// customerID is bigcommerce customer id
+func (bc *Client) IterateAddresses(customerID int64) *Iterator[Address] {
+	return NewIterator[Address](bc, "/v3/customers/addresses", map[string]string{
+		"customer_id:in": strconv.FormatInt(customerID, 10),
+	})
 }
 
-// GetAddressPage returns all addresses for a curstomer, handling pagination
+// GetAddressPage returns a page of addresses for a curstomer
 // customerID is bigcommerce customer id
 // page: the page number to download
 func (bc *Client) GetAddressPage(customerID int64, page int) ([]Address, bool, error) {
-	url := "/v3/customers/addresses?customer_id:in=" + strconv.FormatInt(customerID, 10) + "&page=" + strconv.Itoa(page)
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, false, err
-	}
-
-	var pp struct {
-		Data []Address `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
+	q := url.Values{"customer_id:in": {strconv.FormatInt(customerID, 10)}}
+	cs, p, err := getPage[Address](bc, "/v3/customers/addresses", q, page)
 	if err != nil {
 		return nil, false, err
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return cs, p.CurrentPage < p.TotalPages, nil
 }
 
 // CreateAddress creates a new address for a customer from given data, ignoring ID (duplicating address)
@@ -91,7 +62,7 @@ func (bc *Client) CreateAddress(customerID int64, address *Address) (*Address, e
 	addressJSON, _ := json.Marshal([]Address{*address})
 	//	log.Printf("addressJSON: %s", string(addressJSON))
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(addressJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +95,7 @@ func (bc *Client) UpdateAddress(customerID int64, address *Address) (*Address, e
 	addressJSON, _ := json.Marshal([]Address{*address})
 	//	log.Printf("addressJSON: %s", string(addressJSON))
 	req := bc.getAPIRequest(http.MethodPut, url, bytes.NewReader(addressJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +121,7 @@ func (bc *Client) UpdateAddress(customerID int64, address *Address) (*Address, e
 func (bc *Client) DeleteAddress(customerID, addressID int64) error {
 	url := "/v3/customers/addresses?id:in=" + strconv.FormatInt(addressID, 10)
 	req := bc.getAPIRequest(http.MethodDelete, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
@@ -158,16 +129,6 @@ func (bc *Client) DeleteAddress(customerID, addressID int64) error {
 	if res.StatusCode == http.StatusNoContent {
 		return nil
 	}
-	body, err := processBody(res)
-	if err != nil {
-		log.Printf("error processing body: %s", err)
-		return err
-	}
-	var addr Address
-	err = json.Unmarshal(body, &addr)
-	if err != nil {
-		log.Printf("error parsing body: %s %s", err, string(body))
-		return err
-	}
-	return nil
+	_, err = processBody(res)
+	return err
 }
@@ -1,8 +1,10 @@
 package bigcommerce
 
 import (
+	"context"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -18,9 +20,16 @@ type App struct {
 	Hostname        string
 	AppClientID     string
 	AppClientSecret string
-	HTTPClient      HTTPClient
-	MaxRetries      int
-	ChannelID       int
+	// LoginURL is the OAuth server root for GetAuthContext, DefaultLoginURL if empty
+	LoginURL string
+	// BaseURL is passed to the clients created with NewClient
+	BaseURL     string
+	HTTPClient  HTTPClient
+	MaxRetries  int
+	RetryPolicy *RetryPolicy
+	Logger      Logger
+	ChannelID   int
+	ctx         context.Context
 }
 
 // New returns a new BigCommerce API object with the given hostname, client ID, and client secret
@@ -38,12 +47,44 @@ func NewApp(hostname, appClientID, appClientSecret string) *App {
 	}
 }
 
+// WithContext returns a shallow copy of the app that uses ctx for its requests to BigCommerce
+func (a *App) WithContext(ctx context.Context) *App {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c := *a
+	c.ctx = ctx
+	return &c
+}
+
+// Context returns the app's context, context.Background() if none was set with WithContext
+func (a *App) Context() context.Context {
+	if a.ctx != nil {
+		return a.ctx
+	}
+	return context.Background()
+}
+
+func (a *App) loginURL() string {
+	if a.LoginURL != "" {
+		return strings.TrimSuffix(a.LoginURL, "/")
+	}
+	return DefaultLoginURL
+}
+
+// NewClient returns a Client for the given store that shares the app's HTTP client and context
 func (a *App) NewClient(storeHash, xAuthToken string) *Client {
 	return &Client{
-		StoreHash:  storeHash,
-		XAuthToken: xAuthToken,
-		MaxRetries: 1,
-		HTTPClient: a.HTTPClient,
-		ChannelID:  1,
+		StoreHash:        storeHash,
+		XAuthToken:       xAuthToken,
+		BaseURL:          a.BaseURL,
+		MaxRetries:       a.MaxRetries,
+		RetryPolicy:      a.RetryPolicy,
+		Logger:           a.Logger,
+		HTTPClient:       a.HTTPClient,
+		ChannelID:        1,
+		RateLimitReserve: 1,
+		ctx:              a.ctx,
+		rateLimit:        &rateLimiter{},
 	}
 }
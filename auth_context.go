@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // GetAuthContext returns an AuthContext object from the BigCommerce API
@@ -27,13 +29,18 @@ func (bc *App) GetAuthContext(requestURLQuery url.Values) (*AuthContext, error)
 		return nil, err
 	}
 
-	res, err := bc.HTTPClient.Post("https://login.bigcommerce.com/oauth2/token",
-		"application/json",
-		bytes.NewReader(reqb),
-	)
+	hreq, err := http.NewRequestWithContext(bc.Context(), http.MethodPost, bc.loginURL()+"/oauth2/token", bytes.NewReader(reqb))
 	if err != nil {
 		return nil, err
 	}
+	hreq.Header.Set("Content-Type", "application/json")
+	start := time.Now()
+	res, err := bc.HTTPClient.Do(hreq)
+	if err != nil {
+		bc.logger().Error("bigcommerce auth request error", "duration", time.Since(start), "error", logError(err))
+		return nil, err
+	}
+	bc.logger().Debug("bigcommerce auth request", "status", res.StatusCode, "duration", time.Since(start))
 
 	bytes, err := ioutil.ReadAll(res.Body)
 	if err != nil {
@@ -41,6 +48,9 @@ func (bc *App) GetAuthContext(requestURLQuery url.Values) (*AuthContext, error)
 	}
 	res.Body.Close()
 
+	if res.StatusCode > 299 {
+		return nil, newAPIError(res, bytes)
+	}
 	if strings.Contains(string(bytes), "invalid_") {
 		return nil, fmt.Errorf("%s", string(bytes))
 	}
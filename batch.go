@@ -0,0 +1,104 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// BatchItemError is the failure of one item of a batch request
+type BatchItemError struct {
+	ID  int64 // ID of the item, e.g. product ID
+	Err error
+}
+
+// BatchError is returned by batch calls when some of the items failed, the others succeeded
+type BatchError struct {
+	Total  int // number of items sent
+	Failed []BatchItemError
+}
+
+func (e *BatchError) Error() string {
+	parts := make([]string, 0, len(e.Failed))
+	for _, f := range e.Failed {
+		parts = append(parts, fmt.Sprintf("%d: %v", f.ID, f.Err))
+	}
+	return fmt.Sprintf("%d of %d items failed: %s", len(e.Failed), e.Total, strings.Join(parts, "; "))
+}
+
+// isItemError tells if err is a rejection of the request content (4xx other than 401, 403 and 429),
+// as opposed to a failure that would affect any request
+func isItemError(err error) bool {
+	code := StatusCode(err)
+	switch code {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return false
+	}
+	return code >= 400 && code < 500
+}
+
+// batchUpdate sends items in chunks of size. When a chunk is rejected for its content,
+// its items are resent one by one and the failing ones are reported in a *BatchError
+// id returns the ID of an item for the error report
+func batchUpdate[P, R any](items []P, size int, id func(P) int64, send func([]P) ([]R, error)) ([]R, error) {
+	ret := []R{}
+	var failed []BatchItemError
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		rs, err := send(items[start:end])
+		if err == nil {
+			ret = append(ret, rs...)
+			continue
+		}
+		if !isItemError(err) {
+			return ret, err
+		}
+		if end-start == 1 {
+			failed = append(failed, BatchItemError{ID: id(items[start]), Err: err})
+			continue
+		}
+		// find out which items were rejected
+		for _, item := range items[start:end] {
+			rs, err := send([]P{item})
+			if err != nil {
+				if !isItemError(err) {
+					return ret, err
+				}
+				failed = append(failed, BatchItemError{ID: id(item), Err: err})
+				continue
+			}
+			ret = append(ret, rs...)
+		}
+	}
+	if len(failed) > 0 {
+		return ret, &BatchError{Total: len(items), Failed: failed}
+	}
+	return ret, nil
+}
+
+// indexedItem is an item of a batch with its position in the input
+type indexedItem[P any] struct {
+	i    int
+	item P
+}
+
+// batchUpdateByIndex is batchUpdate for items that have no ID yet or not always,
+// failing items are reported in a *BatchError with their index in items as ID
+func batchUpdateByIndex[P, R any](items []P, size int, send func([]P) ([]R, error)) ([]R, error) {
+	indexed := make([]indexedItem[P], len(items))
+	for i, item := range items {
+		indexed[i] = indexedItem[P]{i: i, item: item}
+	}
+	return batchUpdate(indexed, size,
+		func(it indexedItem[P]) int64 { return int64(it.i) },
+		func(batch []indexedItem[P]) ([]R, error) {
+			payload := make([]P, len(batch))
+			for i := range batch {
+				payload[i] = batch[i].item
+			}
+			return send(payload)
+		})
+}
@@ -0,0 +1,77 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestBatchUpdate(t *testing.T) {
+	rejected := map[int64]bool{2: true, 5: true}
+	var sent [][]int64
+	send := func(batch []int64) ([]int64, error) {
+		sent = append(sent, batch)
+		for _, id := range batch {
+			if rejected[id] {
+				return nil, &APIError{StatusCode: http.StatusUnprocessableEntity}
+			}
+		}
+		return batch, nil
+	}
+	ret, err := batchUpdate([]int64{1, 2, 3, 4, 5}, 2, func(id int64) int64 { return id }, send)
+
+	if want := []int64{1, 3, 4}; !reflect.DeepEqual(ret, want) {
+		t.Errorf("ret = %v, want %v", ret, want)
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("error = %v, want a *BatchError", err)
+	}
+	var failed []int64
+	for _, f := range batchErr.Failed {
+		failed = append(failed, f.ID)
+	}
+	if want := []int64{2, 5}; !reflect.DeepEqual(failed, want) || batchErr.Total != 5 {
+		t.Errorf("failed = %v of %d, want %v of 5", failed, batchErr.Total, want)
+	}
+	// the rejected one-item chunk is not resent
+	if want := [][]int64{{1, 2}, {1}, {2}, {3, 4}, {5}}; !reflect.DeepEqual(sent, want) {
+		t.Errorf("sent = %v, want %v", sent, want)
+	}
+}
+
+func TestBatchUpdateStopsOnRequestError(t *testing.T) {
+	send := func(batch []int64) ([]int64, error) {
+		if batch[0] == 3 {
+			return nil, &APIError{StatusCode: http.StatusUnauthorized}
+		}
+		return batch, nil
+	}
+	ret, err := batchUpdate([]int64{1, 2, 3, 4}, 2, func(id int64) int64 { return id }, send)
+	if StatusCode(err) != http.StatusUnauthorized {
+		t.Errorf("error = %v, want the 401", err)
+	}
+	if want := []int64{1, 2}; !reflect.DeepEqual(ret, want) {
+		t.Errorf("ret = %v, want %v", ret, want)
+	}
+}
+
+func TestBatchUpdateByIndex(t *testing.T) {
+	send := func(batch []string) ([]string, error) {
+		for _, s := range batch {
+			if s == "bad" {
+				return nil, &APIError{StatusCode: http.StatusUnprocessableEntity}
+			}
+		}
+		return batch, nil
+	}
+	ret, err := batchUpdateByIndex([]string{"a", "b", "bad", "c"}, 3, send)
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(ret, want) {
+		t.Errorf("ret = %v, want %v", ret, want)
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Failed) != 1 || batchErr.Failed[0].ID != 2 {
+		t.Errorf("error = %v, want item 2 failed", err)
+	}
+}
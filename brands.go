@@ -1,10 +1,11 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"fmt"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 // Brand is BigCommerce brand object
@@ -26,65 +27,120 @@ type Brand struct {
 // GetAllBrands returns all brands, handling pagination
 // args is a map of arguments to pass to the API
 func (bc *Client) GetAllBrands(args map[string]string) ([]Brand, error) {
-	cs := []Brand{}
-	var csp []Brand
-	page := 1
-	more := true
-	extidmap := map[int64]int{}
-	var err error
-	var retries int
-	for more {
-		csp, more, err = bc.GetBrands(args, page)
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				return cs, fmt.Errorf("max retries reached")
-			}
-			break
-		}
-		cs = append(cs, csp...)
-		page++
-	}
-	for i, c := range cs {
-		extidmap[c.ID] = i
-	}
+	cs, err := bc.IterateBrands(args).All()
 	for i := range cs {
 		cs[i].URL = cs[i].CustomURL.URL
 	}
 	return cs, err
 }
 
-// GetBrands returns all brands, handling pagination
+// IterateBrands returns an iterator over all brands, fetching them page by page
+// args is a map of arguments to pass to the API
+func (bc *Client) IterateBrands(args map[string]string) *Iterator[Brand] {
+	return NewIterator[Brand](bc, "/v3/catalog/brands", args)
+}
+
+// GetBrands returns a page of brands
 // args is a map of arguments to pass to the API
 // page: the page number to download
 func (bc *Client) GetBrands(args map[string]string, page int) ([]Brand, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/catalog/brands?page=" + strconv.Itoa(page) + fpart
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	cs, p, err := getPage[Brand](bc, "/v3/catalog/brands", argsQuery(args), page)
 	if err != nil {
 		return nil, false, err
 	}
+	return cs, p.CurrentPage < p.TotalPages, nil
+}
 
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, false, err
+// BrandPayload is the body of CreateBrand and UpdateBrand, only the non-nil fields are sent
+// Name is required to create a brand
+type BrandPayload struct {
+	Name            *string   `json:"name,omitempty"`
+	PageTitle       *string   `json:"page_title,omitempty"`
+	MetaKeywords    *[]string `json:"meta_keywords,omitempty"`
+	MetaDescription *string   `json:"meta_description,omitempty"`
+	ImageURL        *string   `json:"image_url,omitempty"`
+	SearchKeywords  *string   `json:"search_keywords,omitempty"`
+}
+
+func brandPath(brandID int64) string {
+	return "/v3/catalog/brands/" + strconv.FormatInt(brandID, 10)
+}
+
+// GetBrandByID returns a brand by ID
+func (bc *Client) GetBrandByID(brandID int64) (*Brand, error) {
+	return bc.sendBrand(http.MethodGet, brandPath(brandID), nil)
+}
+
+// GetBrandByName returns the brand with the given name, ErrNotFound if there is none
+func (bc *Client) GetBrandByName(name string) (*Brand, error) {
+	bs, _, err := bc.GetBrands(map[string]string{"name": name}, 1)
+	if err != nil && err != ErrNoContent {
+		return nil, err
+	}
+	for _, b := range bs {
+		if strings.EqualFold(b.Name, name) {
+			b.URL = b.CustomURL.URL
+			return &b, nil
+		}
 	}
+	return nil, ErrNotFound
+}
+
+// CreateBrand creates a brand, brand names are unique
+func (bc *Client) CreateBrand(payload *BrandPayload) (*Brand, error) {
+	if payload.Name == nil || *payload.Name == "" {
+		return nil, errors.New("brand name is required")
+	}
+	return bc.sendBrand(http.MethodPost, "/v3/catalog/brands", payload)
+}
+
+// UpdateBrand updates the given fields of a brand and returns the updated brand
+func (bc *Client) UpdateBrand(brandID int64, patch *BrandPayload) (*Brand, error) {
+	return bc.sendBrand(http.MethodPut, brandPath(brandID), patch)
+}
+
+// DeleteBrand deletes a brand, its products are left without a brand
+func (bc *Client) DeleteBrand(brandID int64) error {
+	return bc.sendJSON(http.MethodDelete, brandPath(brandID), nil, nil)
+}
+
+// UpsertBrand returns the brand with the given name, creating it if it doesn't exist
+func (bc *Client) UpsertBrand(name string) (*Brand, error) {
+	b, err := bc.GetBrandByName(name)
+	if !IsNotFound(err) {
+		return b, err
+	}
+	b, err = bc.CreateBrand(&BrandPayload{Name: &name})
+	if IsConflict(err) || IsValidation(err) {
+		// created concurrently
+		if existing, getErr := bc.GetBrandByName(name); getErr == nil {
+			return existing, nil
+		}
+	}
+	return b, err
+}
 
-	var pp struct {
-		Data []Brand `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
+// UploadBrandImage uploads an image file as the brand image, replacing the previous one
+// returns the URL of the new image
+func (bc *Client) UploadBrandImage(brandID int64, r io.Reader, filename string) (string, error) {
+	var ret struct {
+		ImageURL string `json:"image_url"`
 	}
-	err = json.Unmarshal(body, &pp)
+	err := bc.sendMultipart(brandPath(brandID)+"/image", "image_file", filename, r, nil, &ret)
+	return ret.ImageURL, err
+}
+
+// DeleteBrandImage removes the image of a brand
+func (bc *Client) DeleteBrandImage(brandID int64) error {
+	return bc.sendJSON(http.MethodDelete, brandPath(brandID)+"/image", nil, nil)
+}
+
+func (bc *Client) sendBrand(method, path string, payload interface{}) (*Brand, error) {
+	var ret Brand
+	err := bc.sendData(method, path, payload, &ret)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	ret.URL = ret.CustomURL.URL
+	return &ret, nil
 }
@@ -0,0 +1,62 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// types of a BulkPricingRule, how Amount applies to the unit price
+const (
+	BulkPricingPrice   = "price"   // Amount is subtracted from the price
+	BulkPricingPercent = "percent" // Amount is a percentage discount
+	BulkPricingFixed   = "fixed"   // Amount is the new unit price
+)
+
+func bulkPricingPath(productID int64) string {
+	return "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/bulk-pricing-rules"
+}
+
+// GetBulkPricingRules gets all bulk pricing rules of a product
+// productID: BigCommerce product ID
+func (bc *Client) GetBulkPricingRules(productID int64) ([]BulkPricingRule, error) {
+	return NewIterator[BulkPricingRule](bc, bulkPricingPath(productID), nil).Limit(250).All()
+}
+
+// GetBulkPricingRule gets a bulk pricing rule of a product by ID
+func (bc *Client) GetBulkPricingRule(productID, ruleID int64) (*BulkPricingRule, error) {
+	var ret BulkPricingRule
+	err := bc.sendData(http.MethodGet, bulkPricingPath(productID)+"/"+strconv.FormatInt(ruleID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateBulkPricingRule adds a bulk pricing rule to a product, quantity ranges must not overlap
+func (bc *Client) CreateBulkPricingRule(productID int64, rule *BulkPricingRule) (*BulkPricingRule, error) {
+	r := *rule
+	r.ID = 0
+	var ret BulkPricingRule
+	err := bc.sendData(http.MethodPost, bulkPricingPath(productID), r, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateBulkPricingRule updates a bulk pricing rule of a product
+func (bc *Client) UpdateBulkPricingRule(productID, ruleID int64, rule *BulkPricingRule) (*BulkPricingRule, error) {
+	r := *rule
+	r.ID = 0
+	var ret BulkPricingRule
+	err := bc.sendData(http.MethodPut, bulkPricingPath(productID)+"/"+strconv.FormatInt(ruleID, 10), r, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteBulkPricingRule deletes a bulk pricing rule of a product
+func (bc *Client) DeleteBulkPricingRule(productID, ruleID int64) error {
+	return bc.sendJSON(http.MethodDelete, bulkPricingPath(productID)+"/"+strconv.FormatInt(ruleID, 10), nil, nil)
+}
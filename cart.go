@@ -19,9 +19,9 @@ type Cart struct {
 		Code string `json:"code,omitempty"`
 	} `json:"currency,omitempty"`
 	TaxIncluded    bool         `json:"tax_included,omitempty"`
-	BaseAmount     float64      `json:"base_amount,omitempty"`
-	DiscountAmount float64      `json:"discount_amount,omitempty"`
-	CartAmount     float64      `json:"cart_amount,omitempty"`
+	BaseAmount     Decimal      `json:"base_amount"`
+	DiscountAmount Decimal      `json:"discount_amount"`
+	CartAmount     Decimal      `json:"cart_amount"`
 	Discounts      []Discount   `json:"discounts,omitempty"`
 	Coupons        []CartCoupon `json:"coupons,omitempty"`
 	LineItems      struct {
@@ -54,17 +54,42 @@ type LineItem struct {
 	ImageURL          string      `json:"image_url,omitempty"`
 	Discounts         []Discount  `json:"discounts,omitempty"`
 	Coupons           interface{} `json:"coupons,omitempty"`
-	DiscountAmount    float64     `json:"discount_amount,omitempty"`
-	CouponAmount      float64     `json:"coupon_amount,omitempty"`
-	OriginalPrice     float64     `json:"original_price,omitempty"`
-	ListPrice         float64     `json:"list_price,omitempty"`
-	SalePrice         float64     `json:"sale_price,omitempty"`
-	ExtendedListPrice float64     `json:"extended_list_price,omitempty"`
-	ExtendedSalePrice float64     `json:"extended_sale_price,omitempty"`
+	DiscountAmount    Decimal     `json:"discount_amount"`
+	CouponAmount      Decimal     `json:"coupon_amount"`
+	OriginalPrice     Decimal     `json:"original_price"`
+	ListPrice         Decimal     `json:"list_price"`
+	SalePrice         Decimal     `json:"sale_price"`
+	ExtendedListPrice Decimal     `json:"extended_list_price"`
+	ExtendedSalePrice Decimal     `json:"extended_sale_price"`
 	IsRequireShipping bool        `json:"is_require_shipping,omitempty"`
 	IsMutable         bool        `json:"is_mutable,omitempty"`
 }
 
+// MarshalJSON omits the zero amounts of li, so that CreateCart and CartAddItems don't set
+// prices the caller left out
+func (li LineItem) MarshalJSON() ([]byte, error) {
+	type lineItem LineItem
+	return json.Marshal(struct {
+		lineItem
+		DiscountAmount    *Decimal `json:"discount_amount,omitempty"`
+		CouponAmount      *Decimal `json:"coupon_amount,omitempty"`
+		OriginalPrice     *Decimal `json:"original_price,omitempty"`
+		ListPrice         *Decimal `json:"list_price,omitempty"`
+		SalePrice         *Decimal `json:"sale_price,omitempty"`
+		ExtendedListPrice *Decimal `json:"extended_list_price,omitempty"`
+		ExtendedSalePrice *Decimal `json:"extended_sale_price,omitempty"`
+	}{
+		lineItem(li),
+		li.DiscountAmount.orNil(),
+		li.CouponAmount.orNil(),
+		li.OriginalPrice.orNil(),
+		li.ListPrice.orNil(),
+		li.SalePrice.orNil(),
+		li.ExtendedListPrice.orNil(),
+		li.ExtendedSalePrice.orNil(),
+	})
+}
+
 type CartURLs struct {
 	CartURL             string `json:"cart_url,omitempty"`
 	CheckoutURL         string `json:"checkout_url,omitempty"`
@@ -79,7 +104,7 @@ func (bc *Client) CreateCart(items []LineItem) (*Cart, error) {
 		"line_items": items,
 	})
 	req := bc.getAPIRequest(http.MethodPost, "/v3/carts?include=redirect_urls", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +127,7 @@ func (bc *Client) CreateCart(items []LineItem) (*Cart, error) {
 // GetCart gets a cart by ID from BigCommerce and returns it
 func (bc *Client) GetCart(cartID string) (*Cart, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/carts/"+cartID+"?include=redirect_urls", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -129,13 +154,13 @@ func (bc *Client) CartAddItems(cartID string, items []LineItem) (*Cart, error) {
 		"line_items": items,
 	})
 	req := bc.getAPIRequest(http.MethodPost, "/v3/carts/"+cartID+"/items?include=redirect_urls", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	b, err := processBody(res)
 	if err != nil {
-		return nil, fmt.Errorf("%s", string(b))
+		return nil, err
 	}
 	var cartResponse struct {
 		Data Cart `json:"data,omitempty"`
@@ -159,13 +184,13 @@ func (bc *Client) CartEditItem(cartID string, item LineItem) (*Cart, error) {
 		"line_item": item,
 	})
 	req := bc.getAPIRequest(http.MethodPut, "/v3/carts/"+cartID+"/items/"+item.ID+"?include=redirect_urls", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
-	b, err := processBody(res)
+	_, err = processBody(res)
 	if err != nil {
-		return nil, fmt.Errorf("%s", string(b))
+		return nil, err
 	}
 	return bc.GetCart(cartID)
 }
@@ -177,7 +202,7 @@ func (bc *Client) CartEditItem(cartID string, item LineItem) (*Cart, error) {
 // returns nil for empty cart
 func (bc *Client) CartDeleteItem(cartID string, item LineItem) (*Cart, error) {
 	req := bc.getAPIRequest(http.MethodDelete, "/v3/carts/"+cartID+"/items/"+item.ID+"?include=redirect_urls", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +223,7 @@ func (bc *Client) CartDeleteItem(cartID string, item LineItem) (*Cart, error) {
 func (bc *Client) CartUpdateCustomerID(cartID, customerID string) (*Cart, error) {
 	req := bc.getAPIRequest(http.MethodPut, "/v3/carts/"+cartID+"?include=redirect_urls",
 		bytes.NewReader([]byte(fmt.Sprintf(`{"customer_id": %s}`, customerID))))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -219,12 +244,14 @@ func (bc *Client) CartUpdateCustomerID(cartID, customerID string) (*Cart, error)
 // DeleteCart deletes a cart by ID from BigCommerce
 func (bc *Client) DeleteCart(cartID string) error {
 	req := bc.getAPIRequest(http.MethodDelete, "/v3/carts/"+cartID, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
-	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	defer res.Body.Close()
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return err
 	}
 	return nil
 }
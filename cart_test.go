@@ -0,0 +1,16 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLineItemMarshalJSONOmitsZeroAmounts(t *testing.T) {
+	b, err := json.Marshal(LineItem{ProductID: 1, Quantity: 2, ListPrice: DecimalFromInt(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"product_id":1,"quantity":2,"list_price":10}`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+}
@@ -1,10 +1,8 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"fmt"
+	"errors"
 	"net/http"
-	"sort"
 	"strconv"
 )
 
@@ -14,6 +12,8 @@ type Category struct {
 	Name      string `json:"name"`
 	ParentID  int64  `json:"parent_id"`
 	Visible   bool   `json:"is_visible"`
+	SortOrder int    `json:"sort_order"`
+	TreeID    int64  `json:"tree_id,omitempty"`
 	FullName  string `json:"-"`
 	CustomURL struct {
 		URL        string `json:"url"`
@@ -22,94 +22,93 @@ type Category struct {
 	URL string `json:"-"`
 }
 
-// GetAllCategories returns a list of categories, handling pagination
+// CategoryPayload is the body of CreateCategory and UpdateCategory, only the non-nil fields are sent
+// Name is required to create a category, ParentID 0 makes it a top-level category
+type CategoryPayload struct {
+	Name               *string   `json:"name,omitempty"`
+	ParentID           *int64    `json:"parent_id,omitempty"`
+	Description        *string   `json:"description,omitempty"`
+	SortOrder          *int      `json:"sort_order,omitempty"`
+	PageTitle          *string   `json:"page_title,omitempty"`
+	SearchKeywords     *string   `json:"search_keywords,omitempty"`
+	MetaKeywords       *[]string `json:"meta_keywords,omitempty"`
+	MetaDescription    *string   `json:"meta_description,omitempty"`
+	LayoutFile         *string   `json:"layout_file,omitempty"`
+	IsVisible          *bool     `json:"is_visible,omitempty"`
+	DefaultProductSort *string   `json:"default_product_sort,omitempty"`
+	ImageURL           *string   `json:"image_url,omitempty"`
+}
+
+// GetAllCategories returns a list of categories ordered by ID with their "A > B > C" FullName,
+// handling pagination. Parent cycles are reported with a *CategoryCycleError
 // args is a map of arguments to pass to the API
 func (bc *Client) GetAllCategories(args map[string]string) ([]Category, error) {
-	cs := []Category{}
-	var csp []Category
-	page := 1
-	more := true
-	var err error
-	retries := 0
-	for more {
-		csp, more, err = bc.GetCategories(args, page)
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				return cs, fmt.Errorf("max retries reached")
-			}
-			break
-		}
-		cs = append(cs, csp...)
-		page++
+	cs, err := bc.IterateCategories(args).All()
+	tree, cycleErr := NewCategoryTree(cs)
+	cs = tree.Categories()
+	for i := range cs {
+		cs[i].URL = cs[i].CustomURL.URL
 	}
-	cats := map[int64]Category{}
-	ids := []int64{}
-	for _, c := range cs {
-		cats[c.ID] = c
-		//		log.Printf("%d: %s", c.ID, c.Name)
-		ids = append(ids, c.ID)
-	}
-	// sort ids
-	sort.Slice(ids, func(i, j int) bool {
-		return ids[i] < ids[j]
-	})
-	cs = []Category{}
-	for _, i := range ids {
-		c := cats[i]
-		c.URL = c.CustomURL.URL
-		// get A > B > C fancy name
-		c.FullName = bc.getFullCategoryName(cats, i)
-		cs = append(cs, c)
+	if err == nil {
+		err = cycleErr
 	}
 	return cs, err
 }
 
-// GetCategories returns a list of categories, handling pagination
+// IterateCategories returns an iterator over all categories, fetching them page by page
+// args is a map of arguments to pass to the API
+func (bc *Client) IterateCategories(args map[string]string) *Iterator[Category] {
+	return NewIterator[Category](bc, "/v3/catalog/categories", args)
+}
+
+// GetCategories returns a page of categories
 // args is a map of arguments to pass to the API
 // page: the page number to download
 func (bc *Client) GetCategories(args map[string]string, page int) ([]Category, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/catalog/categories?page=" + strconv.Itoa(page) + fpart
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	cs, p, err := getPage[Category](bc, "/v3/catalog/categories", argsQuery(args), page)
 	if err != nil {
 		return nil, false, err
 	}
+	return cs, p.CurrentPage < p.TotalPages, nil
+}
 
-	defer res.Body.Close()
-	body, err := processBody(res)
+// GetCategoryByID returns a category by ID, FullName is not set
+func (bc *Client) GetCategoryByID(categoryID int64) (*Category, error) {
+	var ret Category
+	err := bc.sendData(http.MethodGet, "/v3/catalog/categories/"+strconv.FormatInt(categoryID, 10), nil, &ret)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
+	ret.URL = ret.CustomURL.URL
+	return &ret, nil
+}
 
-	var pp struct {
-		Data []Category `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
+// CreateCategory creates a category in the default category tree
+func (bc *Client) CreateCategory(payload *CategoryPayload) (*Category, error) {
+	if payload.Name == nil || *payload.Name == "" {
+		return nil, errors.New("category name is required")
 	}
-	err = json.Unmarshal(body, &pp)
+	var ret Category
+	err := bc.sendData(http.MethodPost, "/v3/catalog/categories", payload, &ret)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	ret.URL = ret.CustomURL.URL
+	return &ret, nil
 }
 
-func (bc *Client) getFullCategoryName(cats map[int64]Category, i int64) string {
-	c := cats[i]
-	if c.ParentID == 0 {
-		return c.Name
-	}
-	if c.FullName != "" {
-		return c.FullName
+// UpdateCategory updates the given fields of a category and returns the updated category
+func (bc *Client) UpdateCategory(categoryID int64, patch *CategoryPayload) (*Category, error) {
+	var ret Category
+	err := bc.sendData(http.MethodPut, "/v3/catalog/categories/"+strconv.FormatInt(categoryID, 10), patch, &ret)
+	if err != nil {
+		return nil, err
 	}
-	pn := bc.getFullCategoryName(cats, cats[i].ParentID)
-	c.FullName = pn + " > " + c.Name
-	cats[i] = c
-	return c.FullName
+	ret.URL = ret.CustomURL.URL
+	return &ret, nil
+}
+
+// DeleteCategory deletes a category, BigCommerce refuses to delete categories with subcategories
+func (bc *Client) DeleteCategory(categoryID int64) error {
+	return bc.sendJSON(http.MethodDelete, "/v3/catalog/categories/"+strconv.FormatInt(categoryID, 10), nil, nil)
 }
@@ -0,0 +1,214 @@
+package bigcommerce
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CategoryPathSeparator separates the category names of a full name, e.g. "A > B > C"
+const CategoryPathSeparator = " > "
+
+// CategoryTree is an in-memory category hierarchy built from a flat list of categories,
+// see GetCategoryTree and NewCategoryTree
+type CategoryTree struct {
+	nodes map[int64]*CategoryNode
+	roots []*CategoryNode
+}
+
+// CategoryNode is a category in a CategoryTree, Category.FullName is set
+type CategoryNode struct {
+	Category
+	Parent   *CategoryNode // nil for top-level categories
+	Children []*CategoryNode
+	Depth    int // 0 for top-level categories
+}
+
+// CategoryCycleError is returned by NewCategoryTree when parent IDs form a cycle,
+// the tree is still built with the first category of each cycle moved to the top level
+type CategoryCycleError struct {
+	Cycles [][]int64 // category IDs of each cycle, from child to parent
+}
+
+func (e *CategoryCycleError) Error() string {
+	parts := make([]string, 0, len(e.Cycles))
+	for _, c := range e.Cycles {
+		ids := make([]string, len(c))
+		for i, id := range c {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+		parts = append(parts, strings.Join(ids, " -> "))
+	}
+	return "category parent cycle: " + strings.Join(parts, "; ")
+}
+
+// NewCategoryTree builds the hierarchy of cats by ParentID. Categories whose parent is not in cats
+// are top-level. Children are ordered by SortOrder, then name.
+// returns a *CategoryCycleError with the tree if some parent IDs form a cycle
+func NewCategoryTree(cats []Category) (*CategoryTree, error) {
+	t := &CategoryTree{nodes: make(map[int64]*CategoryNode, len(cats))}
+	ids := make([]int64, 0, len(cats))
+	for _, c := range cats {
+		if t.nodes[c.ID] == nil {
+			t.nodes[c.ID] = &CategoryNode{Category: c}
+			ids = append(ids, c.ID)
+		}
+	}
+
+	// walk up from every category, reaching a category of the current walk again closes a cycle
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[int64]int{}
+	cut := map[int64]bool{}
+	var cycles [][]int64
+	for _, start := range ids {
+		var walk []int64
+		for id := start; state[id] != done; {
+			if state[id] == visiting {
+				i := 0
+				for walk[i] != id {
+					i++
+				}
+				cycle := append([]int64(nil), walk[i:]...)
+				cycles = append(cycles, cycle)
+				cut[cycle[0]] = true
+				break
+			}
+			state[id] = visiting
+			walk = append(walk, id)
+			p := t.nodes[id].ParentID
+			if p == 0 || t.nodes[p] == nil {
+				break
+			}
+			id = p
+		}
+		for _, id := range walk {
+			state[id] = done
+		}
+	}
+
+	for _, id := range ids {
+		n := t.nodes[id]
+		if p := t.nodes[n.ParentID]; p != nil && n.ParentID != 0 && !cut[n.ID] {
+			n.Parent = p
+			p.Children = append(p.Children, n)
+		} else {
+			t.roots = append(t.roots, n)
+		}
+	}
+	sortCategoryNodes(t.roots)
+	var visit func(nodes []*CategoryNode, depth int, prefix string)
+	visit = func(nodes []*CategoryNode, depth int, prefix string) {
+		for _, n := range nodes {
+			n.Depth = depth
+			n.FullName = prefix + n.Name
+			sortCategoryNodes(n.Children)
+			visit(n.Children, depth+1, n.FullName+CategoryPathSeparator)
+		}
+	}
+	visit(t.roots, 0, "")
+
+	if len(cycles) > 0 {
+		return t, &CategoryCycleError{Cycles: cycles}
+	}
+	return t, nil
+}
+
+func sortCategoryNodes(nodes []*CategoryNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].SortOrder != nodes[j].SortOrder {
+			return nodes[i].SortOrder < nodes[j].SortOrder
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+}
+
+// Roots returns the top-level categories
+func (t *CategoryTree) Roots() []*CategoryNode {
+	return t.roots
+}
+
+// Node returns the category with the given ID, nil if it's not in the tree
+func (t *CategoryTree) Node(id int64) *CategoryNode {
+	return t.nodes[id]
+}
+
+// Len returns the number of categories in the tree
+func (t *CategoryTree) Len() int {
+	return len(t.nodes)
+}
+
+// Categories returns all categories ordered by ID, with FullName set
+func (t *CategoryTree) Categories() []Category {
+	ret := make([]Category, 0, len(t.nodes))
+	for _, n := range t.nodes {
+		ret = append(ret, n.Category)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
+// Depth returns the depth of a category, 0 for top-level ones, -1 if it's not in the tree
+func (t *CategoryTree) Depth(id int64) int {
+	if n := t.nodes[id]; n != nil {
+		return n.Depth
+	}
+	return -1
+}
+
+// Path returns the IDs from the top-level category down to the category with the given ID,
+// nil if it's not in the tree
+func (t *CategoryTree) Path(id int64) []int64 {
+	n := t.nodes[id]
+	if n == nil {
+		return nil
+	}
+	path := make([]int64, n.Depth+1)
+	for i := n.Depth; n != nil; i, n = i-1, n.Parent {
+		path[i] = n.ID
+	}
+	return path
+}
+
+// Lookup finds a category by its full name, e.g. "Clothing > Shirts > Long sleeve",
+// names are split on CategoryPathSeparator and compared ignoring case and surrounding spaces,
+// so a ">" inside a name, as in "Cables > USB>HDMI", is kept
+func (t *CategoryTree) Lookup(fullName string) (int64, bool) {
+	names := strings.Split(fullName, CategoryPathSeparator)
+	nodes := t.roots
+	var found *CategoryNode
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		found = nil
+		for _, n := range nodes {
+			if strings.EqualFold(strings.TrimSpace(n.Name), name) {
+				found = n
+				break
+			}
+		}
+		if found == nil {
+			return 0, false
+		}
+		nodes = found.Children
+	}
+	return found.ID, true
+}
+
+// Walk calls fn for every category depth first, parents before their children,
+// stopping when fn returns false
+func (t *CategoryTree) Walk(fn func(n *CategoryNode) bool) {
+	var walk func(nodes []*CategoryNode) bool
+	walk = func(nodes []*CategoryNode) bool {
+		for _, n := range nodes {
+			if !fn(n) || !walk(n.Children) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(t.roots)
+}
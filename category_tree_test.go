@@ -0,0 +1,74 @@
+package bigcommerce
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCategoryTreeLookup(t *testing.T) {
+	tree, err := NewCategoryTree([]Category{
+		{ID: 1, Name: "Cables"},
+		{ID: 2, Name: "USB>HDMI", ParentID: 1},
+		{ID: 3, Name: "USB", ParentID: 1},
+		{ID: 4, Name: "HDMI", ParentID: 3},
+		{ID: 5, Name: " Shirts ", ParentID: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		fullName string
+		want     int64
+		found    bool
+	}{
+		{"Cables", 1, true},
+		{"Cables > USB>HDMI", 2, true},
+		{"cables > usb > hdmi", 4, true},
+		{"Cables > Shirts", 5, true},
+		{"  Cables  >  USB  ", 3, true},
+		{"Cables>USB", 0, false},
+		{"Cables > Missing", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, found := tree.Lookup(tt.fullName)
+		if got != tt.want || found != tt.found {
+			t.Errorf("Lookup(%q) = %d, %v, want %d, %v", tt.fullName, got, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestNewCategoryTreeCycles(t *testing.T) {
+	tree, err := NewCategoryTree([]Category{
+		{ID: 1, Name: "A", ParentID: 2},
+		{ID: 2, Name: "B", ParentID: 3},
+		{ID: 3, Name: "C", ParentID: 1},
+		{ID: 4, Name: "D", ParentID: 3},
+		{ID: 5, Name: "E"},
+		{ID: 6, Name: "F", ParentID: 6},
+	})
+	var cycleErr *CategoryCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("error = %v, want a *CategoryCycleError", err)
+	}
+	if want := [][]int64{{1, 2, 3}, {6}}; !reflect.DeepEqual(cycleErr.Cycles, want) {
+		t.Errorf("Cycles = %v, want %v", cycleErr.Cycles, want)
+	}
+	if tree.Len() != 6 {
+		t.Errorf("Len() = %d, want 6", tree.Len())
+	}
+	var roots []int64
+	for _, n := range tree.Roots() {
+		roots = append(roots, n.ID)
+	}
+	if want := []int64{1, 5, 6}; !reflect.DeepEqual(roots, want) {
+		t.Errorf("roots = %v, want %v", roots, want)
+	}
+	if got, want := tree.Path(2), []int64{1, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(2) = %v, want %v", got, want)
+	}
+	if got, want := tree.Node(4).FullName, "A > C > D"; got != want {
+		t.Errorf("FullName = %q, want %q", got, want)
+	}
+}
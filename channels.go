@@ -1,12 +1,6 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"errors"
-	"io/ioutil"
-	"log"
-	"net/http"
-	"strconv"
 	"time"
 )
 
@@ -25,60 +19,22 @@ type Channel struct {
 	Status           string    `json:"status"`
 }
 
+// GetAllChannels returns all channels, handling pagination
 func (bc *Client) GetAllChannels() ([]Channel, error) {
-	cs := []Channel{}
-	var csp []Channel
-	page := 1
-	more := true
-	var err error
-	retries := 0
-	for more {
-		csp, more, err = bc.GetChannels(page)
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				log.Println("Max retries reached")
-				return cs, err
-			}
-			break
-		}
-		cs = append(cs, csp...)
-		page++
-	}
-	return cs, err
+	return bc.IterateChannels().All()
 }
 
-func (bc *Client) GetChannels(page int) ([]Channel, bool, error) {
-	url := "/v3/channels?page=" + strconv.Itoa(page)
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-	defer res.Body.Close()
-	if res.StatusCode == http.StatusNoContent {
-		return nil, false, ErrNoContent
-	}
+// IterateChannels returns an iterator over all channels, fetching them page by page
+func (bc *Client) IterateChannels() *Iterator[Channel] {
+	return NewIterator[Channel](bc, "/v3/channels", nil)
+}
 
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, false, err
-	}
-	var pp struct {
-		Status int       `json:"status"`
-		Title  string    `json:"title"`
-		Data   []Channel `json:"data"`
-		Meta   struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
+// GetChannels returns a page of channels
+// page: the page number to download
+func (bc *Client) GetChannels(page int) ([]Channel, bool, error) {
+	cs, p, err := getPage[Channel](bc, "/v3/channels", nil, page)
 	if err != nil {
 		return nil, false, err
 	}
-	if pp.Status != 0 {
-		return nil, false, errors.New(pp.Title)
-	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return cs, p.CurrentPage < p.TotalPages, nil
 }
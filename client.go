@@ -1,22 +1,50 @@
 package bigcommerce
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
-	"log"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 )
 
+// default hosts of the BigCommerce API and login server
+const (
+	DefaultBaseURL  = "https://api.bigcommerce.com"
+	DefaultLoginURL = "https://login.bigcommerce.com"
+)
+
 type Client struct {
 	StoreHash  string `json:"store-hash"`
 	XAuthToken string `json:"x-auth-token"`
-	MaxRetries int
-	HTTPClient HTTPClient
-	ChannelID  int
+	// BaseURL is the API root that /stores/{store hash}/... is appended to,
+	// DefaultBaseURL if empty. Point it to an httptest.Server or a proxy
+	BaseURL string
+	// StorefrontURL is the storefront root used for the GraphQL Storefront API,
+	// the store's secure URL if empty
+	StorefrontURL string
+	MaxRetries    int
+	HTTPClient    HTTPClient
+	ChannelID     int
+	// RateLimitReserve is the number of requests left in the quota window
+	// at which the client pauses until the window resets
+	RateLimitReserve int
+	// RetryPolicy for transient failures, DefaultRetryPolicy if nil
+	RetryPolicy *RetryPolicy
+	// Logger receives request logs, nothing is logged if nil
+	Logger Logger
+	// LogBodies logs redacted request and response bodies at debug level
+	LogBodies      bool
+	ctx            context.Context
+	rateLimit      *rateLimiter
+	productFields  []string
+	productInclude []string
 }
 
 var ErrNoContent = errors.New("no content 204 from BigCommerce API")
@@ -25,7 +53,7 @@ var ErrNotFound = errors.New("404 not found")
 
 // AuthContexter interface for GetAuthContext
 type AuthContexter interface {
-	GetAuthContext(clientID, clientSecret string, q url.Values) (*AuthContext, error)
+	GetAuthContext(requestURLQuery url.Values) (*AuthContext, error)
 }
 
 func NewClient(storeHash, xAuthToken string) *Client {
@@ -36,24 +64,66 @@ func NewClient(storeHash, xAuthToken string) *Client {
 		HTTPClient: &http.Client{
 			Timeout: time.Second * 10,
 		},
-		ChannelID: 1,
+		ChannelID:        1,
+		RateLimitReserve: 1,
+		rateLimit:        &rateLimiter{},
+	}
+}
+
+// WithContext returns a shallow copy of the client that uses ctx for every request it makes.
+// Cancelling ctx aborts in-flight requests and stops the GetAll* pagination loops
+// e.g. bc.WithContext(r.Context()).GetAllProducts(nil)
+func (bc *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+	bc.limiter() // so that the copy shares the quota tracking
+	c := *bc
+	c.ctx = ctx
+	return &c
+}
+
+// Context returns the client's context, context.Background() if none was set with WithContext
+func (bc *Client) Context() context.Context {
+	if bc.ctx != nil {
+		return bc.ctx
+	}
+	return context.Background()
+}
+
+func (bc *Client) baseURL() string {
+	if bc.BaseURL != "" {
+		return strings.TrimSuffix(bc.BaseURL, "/")
+	}
+	return DefaultBaseURL
+}
+
+// GraphQLURL returns the GraphQL Storefront API endpoint, StorefrontURL + /graphql
+// if StorefrontURL is empty, the store's secure URL is looked up with GetStoreInfo
+func (bc *Client) GraphQLURL() (string, error) {
+	if bc.StorefrontURL != "" {
+		return strings.TrimSuffix(bc.StorefrontURL, "/") + "/graphql", nil
+	}
+	info, err := bc.GetStoreInfo()
+	if err != nil {
+		return "", err
 	}
+	return strings.TrimSuffix(info.SecureURL, "/") + "/graphql", nil
 }
 
 func (bc *Client) getAPIRequest(method, url string, body io.Reader) *http.Request {
 	if !strings.HasPrefix(url, "/") {
 		url = "/" + url
 	}
-	fullURL := "https://api.bigcommerce.com/stores/" + bc.StoreHash + url
+	fullURL := bc.baseURL() + "/stores/" + bc.StoreHash + url
 
-	req, _ := http.NewRequest(method, fullURL, body)
+	req, _ := http.NewRequestWithContext(bc.Context(), method, fullURL, body)
 
 	req.Header.Add("X-Auth-Token", bc.XAuthToken)
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("User-Agent", "BigCommerce-Go-SDK")
 	req.Header.Add("Cache-Control", "no-cache")
-	req.Header.Add("Host", "api.bigcommerce.com")
 	req.Header.Add("Accept-Encoding", "none")
 	req.Header.Add("Connection", "keep-alive")
 	return req
@@ -63,17 +133,92 @@ func processBody(res *http.Response) ([]byte, error) {
 	if res.StatusCode == http.StatusNoContent {
 		return nil, ErrNoContent
 	}
-	if res.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
-	}
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 	res.Body.Close()
 	if res.StatusCode > 299 {
-		log.Printf("%s %s %s", res.Request.Method, res.Request.URL, string(body))
-		return body, errors.New(res.Status)
+		return body, newAPIError(res, body)
 	}
 	return body, nil
 }
+
+// sendJSON sends payload as JSON (no body if nil) and decodes the response body into out (ignored if nil)
+// a 204 No Content response is not an error when out is nil
+func (bc *Client) sendJSON(method, path string, payload, out interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req := bc.getAPIRequest(method, path, body)
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	b, err := processBody(res)
+	if err == ErrNoContent && out == nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
+// sendData is sendJSON for v3 endpoints that wrap the response in {"data": ...}, data is decoded into out
+func (bc *Client) sendData(method, path string, payload, out interface{}) error {
+	if out == nil {
+		return bc.sendJSON(method, path, payload, nil)
+	}
+	ret := struct {
+		Data interface{} `json:"data"`
+	}{Data: out}
+	return bc.sendJSON(method, path, payload, &ret)
+}
+
+// sendMultipart uploads the content of r as the file field of a multipart/form-data body,
+// with the additional form fields, and decodes the {"data": ...} response into out.
+// The body is buffered so the request can be retried
+func (bc *Client) sendMultipart(path, field, filename string, r io.Reader, fields map[string]string, out interface{}) error {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		if err := w.WriteField(k, v); err != nil {
+			return err
+		}
+	}
+	fw, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(fw, r); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	req := bc.getAPIRequest(http.MethodPost, path, bytes.NewReader(buf.Bytes()))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	res, err := bc.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	b, err := processBody(res)
+	if err != nil {
+		return err
+	}
+	ret := struct {
+		Data interface{} `json:"data"`
+	}{Data: out}
+	return json.Unmarshal(b, &ret)
+}
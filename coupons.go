@@ -3,20 +3,19 @@ package bigcommerce
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 )
 
 type Coupon struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Amount      string `json:"amount"`
-	MinPurchase string `json:"min_purchase"`
-	Expires     string `json:"expires"`
-	Enabled     bool   `json:"enabled"`
-	Code        string `json:"code"`
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Amount      *Decimal `json:"amount,omitempty"`       // nil keeps the stored amount on update
+	MinPurchase *Decimal `json:"min_purchase,omitempty"` // nil keeps the stored minimum on update
+	Expires     string   `json:"expires"`
+	Enabled     bool     `json:"enabled"`
+	Code        string   `json:"code"`
 	AppliesTo   struct {
 		Entity string  `json:"entity"`
 		Ids    []int64 `json:"ids"`
@@ -34,7 +33,7 @@ func (bc *Client) CreateCoupon(coupon Coupon) (*Coupon, error) {
 	var body []byte
 	body, _ = json.Marshal(coupon)
 	req := bc.getAPIRequest(http.MethodPost, "/v3/coupons", bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +55,7 @@ func (bc *Client) CreateCoupon(coupon Coupon) (*Coupon, error) {
 
 func (bc *Client) GetCoupon(couponID int64) (*Coupon, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/coupons/"+strconv.FormatInt(couponID, 10), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +79,7 @@ func (bc *Client) UpdateCoupon(couponID int64, coupon Coupon) (*Coupon, error) {
 	var body []byte
 	body, _ = json.Marshal(coupon)
 	req := bc.getAPIRequest(http.MethodPut, "/v3/coupons/"+strconv.FormatInt(couponID, 10), bytes.NewReader(body))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +101,7 @@ func (bc *Client) UpdateCoupon(couponID int64, coupon Coupon) (*Coupon, error) {
 
 func (bc *Client) DeleteCoupon(couponID int64) error {
 	req := bc.getAPIRequest(http.MethodDelete, "/v3/coupons/"+strconv.FormatInt(couponID, 10), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
@@ -122,52 +121,25 @@ func (bc *Client) DeleteCoupon(couponID int64) error {
 	return nil
 }
 
+// GetAllCoupons returns all coupons, handling pagination
+// args is a map of arguments to pass to the API
 func (bc *Client) GetAllCoupons(args map[string]string) ([]Coupon, error) {
-	cs := []Coupon{}
-	var csp []Coupon
-	page := 1
-	more := true
-	var err error
-	var retries int
-	for more {
-		csp, more, err = bc.GetCoupons(args, page)
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				return cs, fmt.Errorf("max retries reached")
-			}
-			break
-		}
-		cs = append(cs, csp...)
-		page++
-	}
-	return cs, err
+	return bc.IterateCoupons(args).All()
 }
 
+// IterateCoupons returns an iterator over all coupons, fetching them page by page
+// args is a map of arguments to pass to the API
+func (bc *Client) IterateCoupons(args map[string]string) *Iterator[Coupon] {
+	return NewIterator[Coupon](bc, "/v3/coupons", args)
+}
+
+// GetCoupons returns a page of coupons
+// args is a map of arguments to pass to the API
+// page: the page number to download
 func (bc *Client) GetCoupons(args map[string]string, page int) ([]Coupon, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/coupons?page=" + strconv.Itoa(page) + fpart
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-	b, err := processBody(res)
-	if err != nil {
-		return nil, false, err
-	}
-	var couponResponse struct {
-		Data []Coupon `json:"data,omitempty"`
-		Meta struct {
-			Pagination Pagination `json:"pagination,omitempty"`
-		} `json:"meta,omitempty"`
-	}
-	err = json.Unmarshal(b, &couponResponse)
+	cs, p, err := getPage[Coupon](bc, "/v3/coupons", argsQuery(args), page)
 	if err != nil {
 		return nil, false, err
 	}
-	return couponResponse.Data, couponResponse.Meta.Pagination.CurrentPage < couponResponse.Meta.Pagination.TotalPages, nil
+	return cs, p.CurrentPage < p.TotalPages, nil
 }
@@ -2,7 +2,6 @@ package bigcommerce
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 )
 
@@ -32,7 +31,7 @@ func (bc *Client) GetCurrencies() ([]Currency, error) {
 	url := "/v2/currencies"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +45,6 @@ func (bc *Client) GetCurrencies() ([]Currency, error) {
 	var cs []Currency
 	err = json.Unmarshal(body, &cs)
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
 	return cs, nil
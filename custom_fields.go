@@ -0,0 +1,145 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func customFieldsPath(productID int64) string {
+	return "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/custom-fields"
+}
+
+// GetCustomFields gets all custom fields of a product
+// productID: BigCommerce product ID
+func (bc *Client) GetCustomFields(productID int64) ([]CustomField, error) {
+	return NewIterator[CustomField](bc, customFieldsPath(productID), nil).Limit(250).All()
+}
+
+// CreateCustomField adds a custom field to a product, the name and value pair must be unique for the product
+func (bc *Client) CreateCustomField(productID int64, field *CustomField) (*CustomField, error) {
+	f := *field
+	f.ID = 0
+	var ret CustomField
+	err := bc.sendData(http.MethodPost, customFieldsPath(productID), f, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateCustomField updates the name or value of a custom field of a product
+func (bc *Client) UpdateCustomField(productID, fieldID int64, field *CustomField) (*CustomField, error) {
+	f := *field
+	f.ID = 0
+	var ret CustomField
+	err := bc.sendData(http.MethodPut, customFieldsPath(productID)+"/"+strconv.FormatInt(fieldID, 10), f, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteCustomField deletes a custom field of a product
+func (bc *Client) DeleteCustomField(productID, fieldID int64) error {
+	return bc.sendJSON(http.MethodDelete, customFieldsPath(productID)+"/"+strconv.FormatInt(fieldID, 10), nil, nil)
+}
+
+// SetCustomFields makes the custom fields of a product equal to desired with the fewest calls.
+// Each desired field is matched to an existing field by ID, or else by name, preferring existing fields
+// with the same value. Matched fields are updated if they differ, unmatched desired fields are created
+// and unmatched existing fields deleted.
+// Deletes are sent first so freed name and value pairs can be reused.
+// returns the custom fields of the product after the changes
+func (bc *Client) SetCustomFields(productID int64, desired []CustomField) ([]CustomField, error) {
+	existing, err := bc.GetCustomFields(productID)
+	if err != nil {
+		return nil, err
+	}
+	creates, updates, deletes := diffCustomFields(existing, desired)
+
+	for _, f := range deletes {
+		if err := bc.DeleteCustomField(productID, f.ID); err != nil && !IsNotFound(err) {
+			return nil, err
+		}
+	}
+	ret := make([]CustomField, 0, len(desired))
+	changed := map[int64]bool{}
+	for _, f := range updates {
+		changed[f.ID] = true
+	}
+	for _, f := range deletes {
+		changed[f.ID] = true
+	}
+	for _, f := range existing {
+		if !changed[f.ID] {
+			ret = append(ret, f)
+		}
+	}
+	for _, f := range updates {
+		updated, err := bc.UpdateCustomField(productID, f.ID, &f)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *updated)
+	}
+	for _, f := range creates {
+		created, err := bc.CreateCustomField(productID, &f)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *created)
+	}
+	return ret, nil
+}
+
+// diffCustomFields returns the fields to create, the ones to update (with the existing ID)
+// and the existing ones to delete to turn existing into desired
+func diffCustomFields(existing, desired []CustomField) (creates, updates, deletes []CustomField) {
+	byID := map[int64]int{}
+	for i, f := range existing {
+		byID[f.ID] = i
+	}
+	used := make([]bool, len(existing))
+	var pending []CustomField
+
+	// exact matches and fields addressed by ID
+	for _, d := range desired {
+		if i, ok := byID[d.ID]; ok && d.ID != 0 && !used[i] {
+			used[i] = true
+			if existing[i].Name != d.Name || existing[i].Value != d.Value {
+				updates = append(updates, CustomField{ID: d.ID, Name: d.Name, Value: d.Value})
+			}
+			continue
+		}
+		found := false
+		for i, e := range existing {
+			if !used[i] && e.Name == d.Name && e.Value == d.Value {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			pending = append(pending, d)
+		}
+	}
+	// same name, new value
+	for _, d := range pending {
+		found := false
+		for i, e := range existing {
+			if !used[i] && e.Name == d.Name {
+				used[i], found = true, true
+				updates = append(updates, CustomField{ID: e.ID, Name: d.Name, Value: d.Value})
+				break
+			}
+		}
+		if !found {
+			creates = append(creates, CustomField{Name: d.Name, Value: d.Value})
+		}
+	}
+	for i, e := range existing {
+		if !used[i] {
+			deletes = append(deletes, e)
+		}
+	}
+	return creates, updates, deletes
+}
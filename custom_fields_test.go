@@ -0,0 +1,67 @@
+package bigcommerce
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffCustomFields(t *testing.T) {
+	existing := []CustomField{
+		{ID: 1, Name: "Color", Value: "Red"},
+		{ID: 2, Name: "Size", Value: "M"},
+		{ID: 3, Name: "Size", Value: "L"},
+		{ID: 4, Name: "Material", Value: "Wool"},
+	}
+	tests := []struct {
+		name                      string
+		desired                   []CustomField
+		creates, updates, deletes []CustomField
+	}{
+		{
+			name:    "unchanged",
+			desired: existing,
+		},
+		{
+			name:    "match by ID",
+			desired: []CustomField{{ID: 1, Name: "Colour", Value: "Red"}, existing[1], existing[2], existing[3]},
+			updates: []CustomField{{ID: 1, Name: "Colour", Value: "Red"}},
+		},
+		{
+			name:    "match by name",
+			desired: []CustomField{{Name: "Color", Value: "Blue"}, existing[1], existing[2], existing[3]},
+			updates: []CustomField{{ID: 1, Name: "Color", Value: "Blue"}},
+		},
+		{
+			name:    "same value preferred over earlier name match",
+			desired: []CustomField{{Name: "Size", Value: "S"}, {Name: "Size", Value: "L"}, existing[0], existing[3]},
+			updates: []CustomField{{ID: 2, Name: "Size", Value: "S"}},
+		},
+		{
+			name:    "create and delete",
+			desired: []CustomField{existing[0], existing[1], existing[2], {Name: "Fit", Value: "Slim"}},
+			creates: []CustomField{{Name: "Fit", Value: "Slim"}},
+			deletes: []CustomField{existing[3]},
+		},
+		{
+			name:    "unknown ID matched by name",
+			desired: []CustomField{{ID: 99, Name: "Material", Value: "Cotton"}, existing[0], existing[1], existing[2]},
+			updates: []CustomField{{ID: 4, Name: "Material", Value: "Cotton"}},
+		},
+		{
+			name:    "empty",
+			deletes: existing,
+		},
+	}
+	for _, tt := range tests {
+		creates, updates, deletes := diffCustomFields(existing, tt.desired)
+		if !reflect.DeepEqual(creates, tt.creates) {
+			t.Errorf("%s: creates = %v, want %v", tt.name, creates, tt.creates)
+		}
+		if !reflect.DeepEqual(updates, tt.updates) {
+			t.Errorf("%s: updates = %v, want %v", tt.name, updates, tt.updates)
+		}
+		if !reflect.DeepEqual(deletes, tt.deletes) {
+			t.Errorf("%s: deletes = %v, want %v", tt.name, deletes, tt.deletes)
+		}
+	}
+}
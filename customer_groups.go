@@ -28,7 +28,7 @@ type DiscountRule struct {
 
 func (bc *Client) GetCustomerGroups() ([]CustomerGroup, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v2/customer_groups", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
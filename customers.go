@@ -5,9 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
-	"strings"
+	"net/url"
 )
 
 // Customer is a struct for the BigCommerce Customer API
@@ -106,7 +105,7 @@ func (bc *Client) ValidateCredentials(email, password string) (int64, error) {
 	var b []byte
 	b, _ = json.Marshal(credReq)
 	req := bc.getAPIRequest(http.MethodPost, "/v3/customers/validate-credentials", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -140,30 +139,13 @@ func (bc *Client) CreateAccount(payload *CreateAccountPayload) (*Customer, error
 	var b []byte
 	b, _ = json.Marshal([]CreateAccountPayload{*payload})
 	req := bc.getAPIRequest(http.MethodPost, "/v3/customers", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return nil, err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return nil, fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return nil, errors.New("unknown error")
-		}
-		log.Printf("Error: %s\nResult: %s", err, string(body))
 		return nil, err
 	}
 	var ret struct {
@@ -187,30 +169,13 @@ func (bc *Client) SaveAccount(payload *SaveAccountPayload) (*Customer, error) {
 	var b []byte
 	b, _ = json.Marshal([]SaveAccountPayload{*payload})
 	req := bc.getAPIRequest(http.MethodPut, "/v3/customers", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return nil, err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return nil, fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return nil, errors.New("unknown error")
-		}
-		log.Printf("Error: %s\nResult: %s", err, string(body))
 		return nil, err
 	}
 	var ret struct {
@@ -233,39 +198,19 @@ func (bc *Client) CustomerSetFormFields(customerID int64, formFields []FormField
 	}
 	var b []byte
 	b, _ = json.Marshal(formFields)
-	log.Printf("Fields: %s", string(b))
 	req := bc.getAPIRequest(http.MethodPut, "/v3/customers/form-field-values", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return errors.New("unknown error")
-		}
-		return err
-	}
-	return nil
+	_, err = processBody(res)
+	return err
 }
 
 func (bc *Client) CustomerGetFormFields(customerID int64) ([]FormField, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/customers/form-field-values?customer_id=%d", customerID), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -281,13 +226,12 @@ func (bc *Client) CustomerGetFormFields(customerID int64) ([]FormField, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("Form fields: %s", string(body))
 	return ret.Data, nil
 }
 
 func (bc *Client) GetCustomerByID(customerID int64) (*Customer, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/customers?id:in=%d", customerID), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -310,8 +254,8 @@ func (bc *Client) GetCustomerByID(customerID int64) (*Customer, error) {
 }
 
 func (bc *Client) GetCustomerByEmail(email string) (*Customer, error) {
-	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/customers?email:in=%s", email), nil)
-	res, err := bc.HTTPClient.Do(req)
+	req := bc.getAPIRequest(http.MethodGet, "/v3/customers?email:in="+url.QueryEscape(email), nil)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,281 @@
+package bigcommerce
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// decimalDigits is the number of fractional digits a Decimal keeps
+const decimalDigits = 6
+
+const decimalScale = 1000000
+
+// Decimal is an exact fixed-point decimal number with 6 fractional digits, used for prices and
+// amounts so they can be summed without float rounding errors. It unmarshals from JSON numbers
+// and strings ("12.5000"), and marshals as a JSON number. The zero value is 0; build other values
+// with DecimalFromInt, DecimalFromFloat or ParseDecimal.
+// Inputs with more than 6 fractional digits are rounded half away from zero. Arithmetic results
+// out of range, about ±9.2 trillion, saturate at the largest or smallest Decimal.
+type Decimal struct {
+	units int64 // millionths
+}
+
+var (
+	maxDecimal = Decimal{math.MaxInt64}
+	minDecimal = Decimal{-math.MaxInt64}
+)
+
+var errDecimalRange = errors.New("decimal out of range")
+
+// saturate returns the Decimal of the given sign and magnitude hi:lo in millionths,
+// clamped to the range of a Decimal
+func saturate(neg bool, hi, lo uint64) Decimal {
+	if hi != 0 || lo > math.MaxInt64 {
+		lo = math.MaxInt64
+	}
+	if neg {
+		return Decimal{-int64(lo)}
+	}
+	return Decimal{int64(lo)}
+}
+
+// abs returns the magnitude of n, also for math.MinInt64
+func abs(n int64) uint64 {
+	if n < 0 {
+		return -uint64(n)
+	}
+	return uint64(n)
+}
+
+// DecimalFromInt returns n as a Decimal
+func DecimalFromInt(n int64) Decimal {
+	return Decimal{decimalScale}.MulInt(n)
+}
+
+// DecimalFromFloat returns the Decimal closest to the shortest representation of f, e.g. 0.1 is 0.1
+func DecimalFromFloat(f float64) Decimal {
+	d, _ := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	return d
+}
+
+// ParseDecimal parses a decimal number such as "12", "-0.5", ".5", "12.5000" or "1.5e3"
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return Decimal{}, err
+		}
+		s = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	intPart, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, frac = s[:i], s[i+1:]
+	}
+	if intPart == "" && frac == "" || !isDigits(intPart) || !isDigits(frac) {
+		return Decimal{}, errors.New("invalid decimal " + strconv.Quote(s))
+	}
+	var units uint64
+	for _, c := range intPart {
+		hi, lo := bits.Mul64(units, 10)
+		units = lo + uint64(c-'0')
+		if hi != 0 || units < lo || units > math.MaxInt64/decimalScale {
+			return Decimal{}, errDecimalRange
+		}
+	}
+	units *= decimalScale
+	var f uint64
+	for i := 0; i < decimalDigits; i++ {
+		f *= 10
+		if i < len(frac) {
+			f += uint64(frac[i] - '0')
+		}
+	}
+	if len(frac) > decimalDigits && frac[decimalDigits] >= '5' {
+		f++ // half away from zero
+	}
+	units += f
+	if units > math.MaxInt64 {
+		return Decimal{}, errDecimalRange
+	}
+	return saturate(neg, 0, units), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns d + o
+func (d Decimal) Add(o Decimal) Decimal {
+	s := d.units + o.units
+	switch {
+	case o.units > 0 && s < d.units:
+		return maxDecimal
+	case o.units < 0 && s > d.units, s == math.MinInt64:
+		return minDecimal
+	}
+	return Decimal{s}
+}
+
+// Sub returns d - o
+func (d Decimal) Sub(o Decimal) Decimal {
+	return d.Add(o.Neg())
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{-d.units}
+}
+
+// Abs returns the absolute value of d
+func (d Decimal) Abs() Decimal {
+	if d.units < 0 {
+		return d.Neg()
+	}
+	return d
+}
+
+// MulInt returns d * n, e.g. a unit price times a quantity
+func (d Decimal) MulInt(n int64) Decimal {
+	hi, lo := bits.Mul64(abs(d.units), abs(n))
+	return saturate((d.units < 0) != (n < 0), hi, lo)
+}
+
+// Mul returns d * o rounded half away from zero to 6 fractional digits, e.g. a price times a tax rate
+func (d Decimal) Mul(o Decimal) Decimal {
+	neg := (d.units < 0) != (o.units < 0)
+	hi, lo := bits.Mul64(abs(d.units), abs(o.units))
+	if hi >= decimalScale {
+		return saturate(neg, 1, 0)
+	}
+	q, r := bits.Div64(hi, lo, decimalScale)
+	if r*2 >= decimalScale {
+		q++
+	}
+	return saturate(neg, 0, q)
+}
+
+// Round rounds d half away from zero to the given number of fractional digits,
+// e.g. Currency.DecimalPlaces
+func (d Decimal) Round(places int) Decimal {
+	if places >= decimalDigits {
+		return d
+	}
+	if places < 0 {
+		places = 0
+	}
+	unit := int64(1)
+	for i := places; i < decimalDigits; i++ {
+		unit *= 10
+	}
+	r := d.units % unit
+	d.units -= r
+	if abs(r)*2 >= uint64(unit) {
+		if r < 0 {
+			return d.Add(Decimal{-unit})
+		}
+		return d.Add(Decimal{unit})
+	}
+	return d
+}
+
+// Cmp returns -1, 0 or 1 as d is less than, equal to or greater than o
+func (d Decimal) Cmp(o Decimal) int {
+	switch {
+	case d.units < o.units:
+		return -1
+	case d.units > o.units:
+		return 1
+	}
+	return 0
+}
+
+// Sign returns -1, 0 or 1 as d is negative, zero or positive
+func (d Decimal) Sign() int {
+	return d.Cmp(Decimal{})
+}
+
+// IsZero tells if d is 0
+func (d Decimal) IsZero() bool {
+	return d.units == 0
+}
+
+// orNil returns nil if d is 0, for omitempty fields of request bodies
+func (d Decimal) orNil() *Decimal {
+	if d.IsZero() {
+		return nil
+	}
+	return &d
+}
+
+// Float64 returns d as a float64, which may not be exact
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns d without trailing zeros, e.g. 12.5
+func (d Decimal) String() string {
+	return strings.TrimSuffix(strings.TrimRight(d.StringFixed(decimalDigits), "0"), ".")
+}
+
+// StringFixed returns d rounded to the given number of fractional digits, e.g. 12.50 for 2
+func (d Decimal) StringFixed(places int) string {
+	if places > decimalDigits {
+		places = decimalDigits
+	}
+	if places < 0 {
+		places = 0
+	}
+	r := d.Round(places)
+	sign := ""
+	u := abs(r.units)
+	if r.units < 0 {
+		sign = "-"
+	}
+	s := sign + strconv.FormatUint(u/decimalScale, 10)
+	if places == 0 {
+		return s
+	}
+	frac := strconv.FormatUint(decimalScale+u%decimalScale, 10)[1:]
+	return s + "." + frac[:places]
+}
+
+// MarshalJSON encodes d as a JSON number
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string, null and "" are 0
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		var err error
+		if s, err = strconv.Unquote(s); err != nil {
+			return err
+		}
+		if strings.TrimSpace(s) == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
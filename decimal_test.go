@@ -0,0 +1,176 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "12", want: "12"},
+		{in: "-0.5", want: "-0.5"},
+		{in: ".5", want: "0.5"},
+		{in: "+3.", want: "3"},
+		{in: "12.5000", want: "12.5"},
+		{in: "1.5e3", want: "1500"},
+		{in: "2.5E-2", want: "0.025"},
+		{in: "0.1234564", want: "0.123456"},
+		{in: "0.1234565", want: "0.123457"},
+		{in: "-0.0000005", want: "-0.000001"},
+		{in: "abc", wantErr: true},
+		{in: "", wantErr: true},
+		{in: ".", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "99999999999999", wantErr: true},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDecimal(%q) = %s, want error", tt.in, d)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `"12.5000"`, want: "12.5"},
+		{in: `12.5`, want: "12.5"},
+		{in: `-3`, want: "-3"},
+		{in: `null`, want: "0"},
+		{in: `""`, want: "0"},
+		{in: `" "`, want: "0"},
+		{in: `"abc"`, wantErr: true},
+		{in: `true`, wantErr: true},
+	}
+	for _, tt := range tests {
+		var v struct {
+			Price Decimal `json:"price"`
+		}
+		err := json.Unmarshal([]byte(`{"price":`+tt.in+`}`), &v)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("unmarshal %s = %s, want error", tt.in, v.Price)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unmarshal %s error: %v", tt.in, err)
+			continue
+		}
+		if got := v.Price.String(); got != tt.want {
+			t.Errorf("unmarshal %s = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(struct {
+		Price Decimal  `json:"price"`
+		Sale  *Decimal `json:"sale,omitempty"`
+	}{Price: mustDecimal(t, "12.50")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"price":12.5}`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		want   string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.004999", 2, "1"},
+		{"-1.005", 2, "-1.01"},
+		{"-1.004", 2, "-1"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"-0.4", 0, "0"},
+		{"1.23456", -1, "1"},
+		{"1.234567", 6, "1.234567"},
+		{"1.234567", 8, "1.234567"},
+	}
+	for _, tt := range tests {
+		if got := mustDecimal(t, tt.in).Round(tt.places).String(); got != tt.want {
+			t.Errorf("%s.Round(%d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalStringFixed(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		want   string
+	}{
+		{"12.5", 2, "12.50"},
+		{"12.5", 0, "13"},
+		{"-0.125", 2, "-0.13"},
+		{"0", 4, "0.0000"},
+		{"-0.001", 2, "0.00"},
+		{"1.5", 9, "1.500000"},
+	}
+	for _, tt := range tests {
+		if got := mustDecimal(t, tt.in).StringFixed(tt.places); got != tt.want {
+			t.Errorf("%s.StringFixed(%d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	max := Decimal{math.MaxInt64}
+	tests := []struct {
+		name string
+		got  Decimal
+		want Decimal
+	}{
+		{"add", mustDecimal(t, "0.1").Add(mustDecimal(t, "0.2")), mustDecimal(t, "0.3")},
+		{"sub", mustDecimal(t, "1").Sub(mustDecimal(t, "2.5")), mustDecimal(t, "-1.5")},
+		{"mul int", mustDecimal(t, "19.99").MulInt(3), mustDecimal(t, "59.97")},
+		{"mul", mustDecimal(t, "19.99").Mul(mustDecimal(t, "0.2")), mustDecimal(t, "3.998")},
+		{"mul rounds", mustDecimal(t, "0.000001").Mul(mustDecimal(t, "0.5")), mustDecimal(t, "0.000001")},
+		{"from int", DecimalFromInt(5), mustDecimal(t, "5")},
+		{"from float", DecimalFromFloat(0.1), mustDecimal(t, "0.1")},
+		{"add saturates", max.Add(DecimalFromInt(1)), max},
+		{"sub saturates", max.Neg().Sub(DecimalFromInt(1)), max.Neg()},
+		{"mul int saturates", max.MulInt(-2), max.Neg()},
+		{"mul saturates", max.Mul(max), max},
+		{"from int saturates", DecimalFromInt(math.MinInt64), max.Neg()},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func mustDecimal(t *testing.T, s string) Decimal {
+	t.Helper()
+	d, err := ParseDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
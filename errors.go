@@ -0,0 +1,162 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is returned for every non-2xx response from BigCommerce
+// use errors.As(err, &apiErr) or the Is* helpers to inspect it
+type APIError struct {
+	StatusCode int               // HTTP status code
+	Status     string            // HTTP status line, e.g. "422 Unprocessable Entity"
+	Method     string            // request method
+	Path       string            // request path without the store prefix, e.g. /v3/customers
+	Title      string            // BigCommerce error title
+	Type       string            // BigCommerce error type URL
+	Detail     string            // BigCommerce error detail, if any
+	Errors     map[string]string // per-field errors, e.g. {"email": "already in use"}
+	Body       []byte            // raw response body
+}
+
+func (e *APIError) Error() string {
+	msg := e.Method + " " + e.Path + ": " + e.Status
+	if e.Title != "" {
+		msg += ": " + e.Title
+	}
+	if len(e.Errors) > 0 {
+		keys := make([]string, 0, len(e.Errors))
+		for k := range e.Errors {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		parts := make([]string, 0, len(keys))
+		for _, k := range keys {
+			parts = append(parts, k+": "+e.Errors[k])
+		}
+		msg += " (" + strings.Join(parts, ", ") + ")"
+	}
+	return msg
+}
+
+// Is makes errors.Is(err, ErrNotFound) true for 404 responses
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// newAPIError builds an APIError from a response and its already read body
+func newAPIError(res *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Body:       body,
+	}
+	if e.Status == "" {
+		e.Status = fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.Path = apiPath(res.Request)
+	}
+	// v3 errors are an object, v2 errors an array of {"status", "message"}
+	var v3 struct {
+		Title  string          `json:"title"`
+		Type   string          `json:"type"`
+		Detail string          `json:"detail"`
+		Errors json.RawMessage `json:"errors"`
+		Error  string          `json:"error"` // OAuth errors from the login server
+	}
+	var v2 []struct {
+		Status  int    `json:"status"`
+		Message string `json:"message"`
+		Details struct {
+			InvalidReason string `json:"invalid_reason"`
+		} `json:"details"`
+	}
+	if json.Unmarshal(body, &v3) == nil {
+		e.Title = v3.Title
+		e.Type = v3.Type
+		e.Detail = v3.Detail
+		if e.Title == "" {
+			e.Title = v3.Error
+		}
+		e.Errors = fieldErrors(v3.Errors)
+	} else if json.Unmarshal(body, &v2) == nil && len(v2) > 0 {
+		e.Title = v2[0].Message
+		if v2[0].Details.InvalidReason != "" {
+			e.Errors = map[string]string{"invalid_reason": v2[0].Details.InvalidReason}
+		}
+	}
+	return e
+}
+
+// fieldErrors decodes the "errors" member, values that are not strings are formatted as JSON
+func fieldErrors(raw json.RawMessage) map[string]string {
+	var m map[string]json.RawMessage
+	if len(raw) == 0 || json.Unmarshal(raw, &m) != nil || len(m) == 0 {
+		return nil
+	}
+	ret := map[string]string{}
+	for k, v := range m {
+		var s string
+		if json.Unmarshal(v, &s) == nil {
+			ret[k] = s
+		} else {
+			ret[k] = string(v)
+		}
+	}
+	return ret
+}
+
+// apiPath returns the request path without the /stores/{hash} prefix
+func apiPath(req *http.Request) string {
+	if req.URL == nil {
+		return ""
+	}
+	p := req.URL.Path
+	if strings.HasPrefix(p, "/stores/") {
+		if i := strings.Index(p[len("/stores/"):], "/"); i >= 0 {
+			p = p[len("/stores/")+i:]
+		}
+	}
+	return p
+}
+
+// StatusCode returns the HTTP status of an APIError, 0 for other errors
+func StatusCode(err error) int {
+	var e *APIError
+	if errors.As(err, &e) {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound tells if err is a 404 response or ErrNotFound
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict tells if err is a 409 Conflict response
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsUnauthorized tells if err is a 401 Unauthorized or 403 Forbidden response (bad token or missing scope)
+func IsUnauthorized(err error) bool {
+	code := StatusCode(err)
+	return code == http.StatusUnauthorized || code == http.StatusForbidden
+}
+
+// IsRateLimited tells if err is a 429 Too Many Requests response
+func IsRateLimited(err error) bool {
+	return StatusCode(err) == http.StatusTooManyRequests
+}
+
+// IsValidation tells if err is a 422 Unprocessable Entity response, see APIError.Errors for details
+func IsValidation(err error) bool {
+	return StatusCode(err) == http.StatusUnprocessableEntity
+}
@@ -0,0 +1,285 @@
+package bigcommerce
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sort directions for the Direction field of the filters
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// ProductFilter holds the query parameters of the v3 products list,
+// pass f.Args() to GetProducts, GetAllProducts, IterateProducts or GetAllProductsParallel
+type ProductFilter struct {
+	IDIn              []int64
+	IDNotIn           []int64
+	Name              string
+	NameLike          string
+	SKU               string
+	SKUIn             []string
+	UPC               string
+	Type              string // physical or digital
+	Keyword           string
+	CategoriesIn      []int64
+	BrandID           int64
+	IsVisible         *bool
+	IsFeatured        *bool
+	IsFreeShipping    *bool
+	Availability      string // available, disabled or preorder
+	PriceMin          *Decimal
+	PriceMax          *Decimal
+	InventoryLevelMin *int
+	InventoryLevelMax *int
+	DateModifiedMin   time.Time
+	DateModifiedMax   time.Time
+	DateCreatedMin    time.Time
+	DateCreatedMax    time.Time
+	Include           []string // subresources, e.g. variants, images, custom_fields
+	IncludeFields     []string
+	ExcludeFields     []string
+	Sort              string // e.g. id, name, sku, price, date_modified
+	Direction         string // SortAsc or SortDesc
+	Limit             int
+}
+
+// Args returns the filter as an args map for the list functions
+func (f ProductFilter) Args() map[string]string {
+	a := map[string]string{}
+	setInts(a, "id:in", f.IDIn)
+	setInts(a, "id:not_in", f.IDNotIn)
+	setString(a, "name", f.Name)
+	setString(a, "name:like", f.NameLike)
+	setString(a, "sku", f.SKU)
+	setStrings(a, "sku:in", f.SKUIn)
+	setString(a, "upc", f.UPC)
+	setString(a, "type", f.Type)
+	setString(a, "keyword", f.Keyword)
+	setInts(a, "categories:in", f.CategoriesIn)
+	setInt64(a, "brand_id", f.BrandID)
+	setBool(a, "is_visible", f.IsVisible)
+	setBool(a, "is_featured", f.IsFeatured)
+	setBool(a, "is_free_shipping", f.IsFreeShipping)
+	setString(a, "availability", f.Availability)
+	setDecimal(a, "price:min", f.PriceMin)
+	setDecimal(a, "price:max", f.PriceMax)
+	setIntPtr(a, "inventory_level:min", f.InventoryLevelMin)
+	setIntPtr(a, "inventory_level:max", f.InventoryLevelMax)
+	setTime(a, "date_modified:min", f.DateModifiedMin)
+	setTime(a, "date_modified:max", f.DateModifiedMax)
+	setTime(a, "date_created:min", f.DateCreatedMin)
+	setTime(a, "date_created:max", f.DateCreatedMax)
+	setStrings(a, "include", f.Include)
+	setStrings(a, "include_fields", f.IncludeFields)
+	setStrings(a, "exclude_fields", f.ExcludeFields)
+	setString(a, "sort", f.Sort)
+	setString(a, "direction", f.Direction)
+	setInt64(a, "limit", int64(f.Limit))
+	return a
+}
+
+// BrandFilter holds the query parameters of the v3 brands list,
+// pass f.Args() to GetBrands, GetAllBrands or IterateBrands
+type BrandFilter struct {
+	IDIn          []int64
+	IDNotIn       []int64
+	Name          string
+	NameLike      string
+	PageTitle     string
+	IncludeFields []string
+	ExcludeFields []string
+	Limit         int
+}
+
+// Args returns the filter as an args map for the list functions
+func (f BrandFilter) Args() map[string]string {
+	a := map[string]string{}
+	setInts(a, "id:in", f.IDIn)
+	setInts(a, "id:not_in", f.IDNotIn)
+	setString(a, "name", f.Name)
+	setString(a, "name:like", f.NameLike)
+	setString(a, "page_title", f.PageTitle)
+	setStrings(a, "include_fields", f.IncludeFields)
+	setStrings(a, "exclude_fields", f.ExcludeFields)
+	setInt64(a, "limit", int64(f.Limit))
+	return a
+}
+
+// CategoryFilter holds the query parameters of the v3 categories list,
+// pass f.Args() to GetCategories, GetAllCategories or IterateCategories
+type CategoryFilter struct {
+	IDIn          []int64
+	IDNotIn       []int64
+	Name          string
+	NameLike      string
+	ParentID      *int64
+	ParentIDIn    []int64
+	PageTitle     string
+	Keyword       string
+	IsVisible     *bool
+	IncludeFields []string
+	ExcludeFields []string
+	Limit         int
+}
+
+// Args returns the filter as an args map for the list functions
+func (f CategoryFilter) Args() map[string]string {
+	a := map[string]string{}
+	setInts(a, "id:in", f.IDIn)
+	setInts(a, "id:not_in", f.IDNotIn)
+	setString(a, "name", f.Name)
+	setString(a, "name:like", f.NameLike)
+	if f.ParentID != nil {
+		a["parent_id"] = strconv.FormatInt(*f.ParentID, 10)
+	}
+	setInts(a, "parent_id:in", f.ParentIDIn)
+	setString(a, "page_title", f.PageTitle)
+	setString(a, "keyword", f.Keyword)
+	setBool(a, "is_visible", f.IsVisible)
+	setStrings(a, "include_fields", f.IncludeFields)
+	setStrings(a, "exclude_fields", f.ExcludeFields)
+	setInt64(a, "limit", int64(f.Limit))
+	return a
+}
+
+// CouponFilter holds the query parameters of the coupons list,
+// pass f.Args() to GetCoupons, GetAllCoupons or IterateCoupons
+type CouponFilter struct {
+	Code        string
+	Name        string
+	Type        string // e.g. per_item_discount, percentage_discount, free_shipping
+	IDMin       int64
+	IDMax       int64
+	ExcludeType string
+	Limit       int
+}
+
+// Args returns the filter as an args map for the list functions
+func (f CouponFilter) Args() map[string]string {
+	a := map[string]string{}
+	setString(a, "code", f.Code)
+	setString(a, "name", f.Name)
+	setString(a, "type", f.Type)
+	setInt64(a, "min_id", f.IDMin)
+	setInt64(a, "max_id", f.IDMax)
+	setString(a, "exclude_type", f.ExcludeType)
+	setInt64(a, "limit", int64(f.Limit))
+	return a
+}
+
+// OrderFilter holds the query parameters of the v2 orders list,
+// pass f.Args() to GetOrders, GetAllOrders, IterateOrders or OrderCount
+type OrderFilter struct {
+	StatusID        *OrderStatus
+	CustomerID      *int64 // 0 for guest orders
+	Email           string
+	MinID           int64
+	MaxID           int64
+	MinTotal        *Decimal
+	MaxTotal        *Decimal
+	MinDateCreated  time.Time
+	MaxDateCreated  time.Time
+	MinDateModified time.Time
+	MaxDateModified time.Time
+	ChannelID       int
+	PaymentMethod   string
+	CartID          string
+	IsDeleted       *bool
+	Sort            string // field:direction, e.g. date_created:desc or id:asc
+	Limit           int    // up to 250, default 50
+}
+
+// Args returns the filter as an args map for the list functions, dates are sent as RFC 1123
+func (f OrderFilter) Args() map[string]string {
+	a := map[string]string{}
+	if f.StatusID != nil {
+		a["status_id"] = strconv.Itoa(int(*f.StatusID))
+	}
+	if f.CustomerID != nil {
+		a["customer_id"] = strconv.FormatInt(*f.CustomerID, 10)
+	}
+	setString(a, "email", f.Email)
+	setInt64(a, "min_id", f.MinID)
+	setInt64(a, "max_id", f.MaxID)
+	setDecimal(a, "min_total", f.MinTotal)
+	setDecimal(a, "max_total", f.MaxTotal)
+	setTimeRFC1123(a, "min_date_created", f.MinDateCreated)
+	setTimeRFC1123(a, "max_date_created", f.MaxDateCreated)
+	setTimeRFC1123(a, "min_date_modified", f.MinDateModified)
+	setTimeRFC1123(a, "max_date_modified", f.MaxDateModified)
+	setInt64(a, "channel_id", int64(f.ChannelID))
+	setString(a, "payment_method", f.PaymentMethod)
+	setString(a, "cart_id", f.CartID)
+	setBool(a, "is_deleted", f.IsDeleted)
+	setString(a, "sort", f.Sort)
+	setInt64(a, "limit", int64(f.Limit))
+	return a
+}
+
+func setString(a map[string]string, key, v string) {
+	if v != "" {
+		a[key] = v
+	}
+}
+
+func setStrings(a map[string]string, key string, v []string) {
+	if len(v) > 0 {
+		a[key] = strings.Join(v, ",")
+	}
+}
+
+func setInt64(a map[string]string, key string, v int64) {
+	if v != 0 {
+		a[key] = strconv.FormatInt(v, 10)
+	}
+}
+
+func setIntPtr(a map[string]string, key string, v *int) {
+	if v != nil {
+		a[key] = strconv.Itoa(*v)
+	}
+}
+
+func setInts(a map[string]string, key string, v []int64) {
+	if len(v) > 0 {
+		a[key] = joinInts(v)
+	}
+}
+
+// setDecimal formats v with the 4 fractional digits BigCommerce keeps for amounts
+func setDecimal(a map[string]string, key string, v *Decimal) {
+	if v != nil {
+		a[key] = v.StringFixed(4)
+	}
+}
+
+func setBool(a map[string]string, key string, v *bool) {
+	if v != nil {
+		a[key] = strconv.FormatBool(*v)
+	}
+}
+
+func setTime(a map[string]string, key string, v time.Time) {
+	if !v.IsZero() {
+		a[key] = v.Format(time.RFC3339)
+	}
+}
+
+// setTimeRFC1123 sets a date in the format of the v2 API, e.g. Tue, 20 Nov 2012 00:00:00 +0000
+func setTimeRFC1123(a map[string]string, key string, v time.Time) {
+	if !v.IsZero() {
+		a[key] = v.UTC().Format(time.RFC1123Z)
+	}
+}
+
+// joinInts returns the ids comma separated, as used by the :in filters
+func joinInts(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
@@ -1,3 +1,3 @@
 module github.com/otkach-text/bigcommerce-api-go
 
-go 1.17
+go 1.18
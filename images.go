@@ -1,8 +1,7 @@
 package bigcommerce
 
 import (
-	"encoding/json"
-	"log"
+	"io"
 	"net/http"
 	"strconv"
 )
@@ -22,38 +21,149 @@ type Image struct {
 	DateModified string `json:"date_modified"`
 }
 
+// ImageOptions are the optional attributes of a new product image
+type ImageOptions struct {
+	IsThumbnail bool   // make it the product's main image
+	SortOrder   *int   // position among the product images
+	Description string // alt text
+}
+
+// ImagePayload is the body of UpdateProductImage, only the non-nil fields are sent
+type ImagePayload struct {
+	IsThumbnail *bool   `json:"is_thumbnail,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ImageURL    *string `json:"image_url,omitempty"` // replaces the image with the one at this URL
+}
+
+func imagesPath(productID int64) string {
+	return "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/images"
+}
+
+func (o *ImageOptions) fields() map[string]string {
+	f := map[string]string{}
+	if o == nil {
+		return f
+	}
+	if o.IsThumbnail {
+		f["is_thumbnail"] = "true"
+	}
+	if o.SortOrder != nil {
+		f["sort_order"] = strconv.Itoa(*o.SortOrder)
+	}
+	if o.Description != "" {
+		f["description"] = o.Description
+	}
+	return f
+}
+
 // GetMainThumbnailURL returns the main thumbnail URL for a product
 // this is due to the fact that the Product API does not return the main thumbnail URL
 func (bc *Client) GetMainThumbnailURL(productID int64) (string, error) {
-	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/images"
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	images, err := bc.GetProductImages(productID)
 	if err != nil {
 		return "", err
 	}
+	for _, p := range images {
+		if p.IsThumbnail {
+			return p.URLThumbnail, nil
+		}
+	}
+	return "", ErrNoMainThumbnail
+}
+
+// GetProductImages gets all images of a product, fetching them page by page
+// productID: BigCommerce product ID
+func (bc *Client) GetProductImages(productID int64) ([]Image, error) {
+	return NewIterator[Image](bc, imagesPath(productID), nil).Limit(250).All()
+}
 
-	defer res.Body.Close()
-	body, err := processBody(res)
+// GetProductImage gets an image of a product by ID
+func (bc *Client) GetProductImage(productID, imageID int64) (*Image, error) {
+	var ret Image
+	err := bc.sendData(http.MethodGet, imagesPath(productID)+"/"+strconv.FormatInt(imageID, 10), nil, &ret)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return &ret, nil
+}
 
-	var pp struct {
-		Data []Image `json:"data"`
-		Meta struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
+// UploadProductImage uploads an image file to a product as multipart/form-data
+// productID: BigCommerce product ID
+// r: the image content (jpg, png, gif, webp, up to 8MB), filename: its file name, e.g. front.jpg
+// opts: optional attributes of the image, may be nil
+func (bc *Client) UploadProductImage(productID int64, r io.Reader, filename string, opts *ImageOptions) (*Image, error) {
+	var ret Image
+	err := bc.sendMultipart(imagesPath(productID), "image_file", filename, r, opts.fields(), &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// AddProductImageFromURL adds an image to a product, BigCommerce downloads it from imageURL
+// opts: optional attributes of the image, may be nil
+func (bc *Client) AddProductImageFromURL(productID int64, imageURL string, opts *ImageOptions) (*Image, error) {
+	payload := struct {
+		ImageURL    string `json:"image_url"`
+		IsThumbnail bool   `json:"is_thumbnail,omitempty"`
+		SortOrder   *int   `json:"sort_order,omitempty"`
+		Description string `json:"description,omitempty"`
+	}{ImageURL: imageURL}
+	if opts != nil {
+		payload.IsThumbnail = opts.IsThumbnail
+		payload.SortOrder = opts.SortOrder
+		payload.Description = opts.Description
 	}
-	err = json.Unmarshal(body, &pp)
+	var ret Image
+	err := bc.sendData(http.MethodPost, imagesPath(productID), payload, &ret)
 	if err != nil {
-		log.Println(err)
-		return "", err
+		return nil, err
 	}
-	for _, p := range pp.Data {
-		if p.IsThumbnail {
-			return p.URLThumbnail, nil
-		}
+	return &ret, nil
+}
+
+// UpdateProductImage updates the sort order, thumbnail flag or description of a product image
+func (bc *Client) UpdateProductImage(productID, imageID int64, patch *ImagePayload) (*Image, error) {
+	var ret Image
+	err := bc.sendData(http.MethodPut, imagesPath(productID)+"/"+strconv.FormatInt(imageID, 10), patch, &ret)
+	if err != nil {
+		return nil, err
 	}
-	return "", ErrNoMainThumbnail
+	return &ret, nil
+}
+
+// DeleteProductImage deletes an image of a product
+func (bc *Client) DeleteProductImage(productID, imageID int64) error {
+	return bc.sendJSON(http.MethodDelete, imagesPath(productID)+"/"+strconv.FormatInt(imageID, 10), nil, nil)
+}
+
+// variantImagePath is the endpoint of the single image of a variant, returned as Variant.ImageURL
+func variantImagePath(productID, variantID int64) string {
+	return variantsPath(productID) + "/" + strconv.FormatInt(variantID, 10) + "/image"
+}
+
+// UploadVariantImage uploads an image file as the image of a variant, replacing the previous one.
+// BigCommerce has no endpoint to remove a variant image, it can only be replaced.
+// returns the URL of the new image
+func (bc *Client) UploadVariantImage(productID, variantID int64, r io.Reader, filename string) (string, error) {
+	var ret struct {
+		ImageURL string `json:"image_url"`
+	}
+	err := bc.sendMultipart(variantImagePath(productID, variantID), "image_file", filename, r, nil, &ret)
+	return ret.ImageURL, err
+}
+
+// AddVariantImageFromURL sets the image of a variant, replacing the previous one, BigCommerce
+// downloads it from imageURL. Variant images can only be replaced, not removed.
+// returns the URL of the new image
+func (bc *Client) AddVariantImageFromURL(productID, variantID int64, imageURL string) (string, error) {
+	payload := struct {
+		ImageURL string `json:"image_url"`
+	}{ImageURL: imageURL}
+	var ret struct {
+		ImageURL string `json:"image_url"`
+	}
+	err := bc.sendData(http.MethodPost, variantImagePath(productID, variantID), payload, &ret)
+	return ret.ImageURL, err
 }
@@ -1,35 +1,40 @@
 package bigcommerce
 
-import (
-	"net/url"
+// compile-time checks that the clients implement the interfaces
+var (
+	_ StoreClient    = (*Client)(nil)
+	_ CatalogClient  = (*Client)(nil)
+	_ BlogClient     = (*Client)(nil)
+	_ CartClient     = (*Client)(nil)
+	_ CustomerClient = (*Client)(nil)
+	_ AddressClient  = (*Client)(nil)
+	_ AuthContexter  = (*App)(nil)
 )
 
 // StoreClient interface handles generic store requests
 type StoreClient interface {
 	GetAllChannels() ([]Channel, error)
 	GetChannels(page int) ([]Channel, bool, error)
-	GetClientRequest(requestURLQuery url.Values) (*ClientRequest, error)
 	GetStoreInfo() (StoreInfo, error)
 }
 
 // CatalogClient interface handles catalog-related requests
 type CatalogClient interface {
-	GetAllBrands() ([]Brand, error)
-	GetBrands(page int) ([]Brand, bool, error)
-	GetAllCategories() ([]Category, error)
-	GetCategories(page int) ([]Category, bool, error)
-	GetClientRequest(requestURLQuery url.Values) (*ClientRequest, error)
+	GetAllBrands(args map[string]string) ([]Brand, error)
+	GetBrands(args map[string]string, page int) ([]Brand, bool, error)
+	GetAllCategories(args map[string]string) ([]Category, error)
+	GetCategories(args map[string]string, page int) ([]Category, bool, error)
 	GetMainThumbnailURL(productID int64) (string, error)
 	SetProductFields(fields []string)
 	SetProductInclude(subresources []string)
-	GetAllProducts(map[string]string) ([]Product, error)
-	GetProducts(page int) ([]Product, bool, error)
+	GetAllProducts(args map[string]string) ([]Product, error)
+	GetProducts(args map[string]string, page int) ([]Product, bool, error)
 	GetProductByID(productID int64) (*Product, error)
 }
 
 // BlogClient interface handles blog-related requests
 type BlogClient interface {
-	GetAllPosts(context, xAuthToken string) ([]Post, error)
+	GetAllPosts() ([]Post, error)
 	GetPosts(page int) ([]Post, bool, error)
 }
 
@@ -0,0 +1,133 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// DefaultLocationID is the ID of the location stores without multi-location inventory use
+const DefaultLocationID = 1
+
+// inventoryBatchSize is the number of items sent per adjustment request
+const inventoryBatchSize = 2000
+
+// InventoryLocation is a place stock is held at, e.g. a warehouse or a retail store
+type InventoryLocation struct {
+	ID                      int64  `json:"id"`
+	Code                    string `json:"code"`
+	Label                   string `json:"label"`
+	Description             string `json:"description"`
+	ManagedByExternalSource bool   `json:"managed_by_external_source"`
+	TypeID                  string `json:"type_id"` // PHYSICAL or VIRTUAL
+	Enabled                 bool   `json:"enabled"`
+	StorefrontVisibility    bool   `json:"storefront_visibility"`
+	TimeZone                string `json:"time_zone"`
+}
+
+// InventoryIdentity identifies the product or variant of an inventory item
+type InventoryIdentity struct {
+	SKU       string `json:"sku"`
+	SkuID     int64  `json:"sku_id"`
+	VariantID int64  `json:"variant_id"`
+	ProductID int64  `json:"product_id"`
+}
+
+// InventoryItem is the stock of a product or variant at a location
+type InventoryItem struct {
+	Identity             InventoryIdentity `json:"identity"`
+	AvailableToSell      int               `json:"available_to_sell"`
+	TotalInventoryOnhand int               `json:"total_inventory_onhand"`
+	Settings             struct {
+		SafetyStock      int    `json:"safety_stock"`
+		IsInStock        bool   `json:"is_in_stock"`
+		WarningLevel     int    `json:"warning_level"`
+		BinPickingNumber string `json:"bin_picking_number"`
+	} `json:"settings"`
+}
+
+// InventoryAdjustment is an item of AdjustInventoryAbsolute and AdjustInventoryRelative,
+// exactly one of SKU, VariantID and ProductID (for products without variants) must be set
+type InventoryAdjustment struct {
+	LocationID int64  `json:"location_id"`
+	SKU        string `json:"sku,omitempty"`
+	VariantID  int64  `json:"variant_id,omitempty"`
+	ProductID  int64  `json:"product_id,omitempty"`
+	Quantity   int    `json:"quantity"`
+}
+
+func (a InventoryAdjustment) validate() error {
+	keys := 0
+	if a.SKU != "" {
+		keys++
+	}
+	if a.VariantID != 0 {
+		keys++
+	}
+	if a.ProductID != 0 {
+		keys++
+	}
+	if keys != 1 {
+		return fmt.Errorf("inventory adjustment needs exactly one of sku, variant_id and product_id, got %+v", a)
+	}
+	if a.LocationID == 0 {
+		return errors.New("inventory adjustment location ID is required")
+	}
+	return nil
+}
+
+// GetInventoryLocations returns the inventory locations
+// args is a map of arguments to pass to the API, e.g. location_id:in or is_active
+func (bc *Client) GetInventoryLocations(args map[string]string) ([]InventoryLocation, error) {
+	return NewIterator[InventoryLocation](bc, "/v3/inventory/locations", args).All()
+}
+
+// GetLocationInventory returns the inventory items at a location
+// args is a map of arguments to pass to the API, e.g. sku:in, variant_id:in or product_id:in
+func (bc *Client) GetLocationInventory(locationID int64, args map[string]string) ([]InventoryItem, error) {
+	path := "/v3/inventory/locations/" + strconv.FormatInt(locationID, 10) + "/items"
+	return NewIterator[InventoryItem](bc, path, args).All()
+}
+
+// AdjustInventoryAbsolute sets the stock of the items to their Quantity, overwriting the current level
+// reason: shown in the inventory history, e.g. "stock take"
+// returns the transaction ID of each request, the items are sent 2000 per request
+func (bc *Client) AdjustInventoryAbsolute(reason string, items []InventoryAdjustment) ([]string, error) {
+	return bc.adjustInventory(http.MethodPut, "/v3/inventory/adjustments/absolute", reason, items)
+}
+
+// AdjustInventoryRelative adds Quantity (negative to remove) to the stock of the items,
+// so concurrent sales are not overwritten
+// reason: shown in the inventory history, e.g. "goods received"
+// returns the transaction ID of each request, the items are sent 2000 per request
+func (bc *Client) AdjustInventoryRelative(reason string, items []InventoryAdjustment) ([]string, error) {
+	return bc.adjustInventory(http.MethodPost, "/v3/inventory/adjustments/relative", reason, items)
+}
+
+func (bc *Client) adjustInventory(method, path, reason string, items []InventoryAdjustment) ([]string, error) {
+	for _, item := range items {
+		if err := item.validate(); err != nil {
+			return nil, err
+		}
+	}
+	ids := []string{}
+	for start := 0; start < len(items); start += inventoryBatchSize {
+		end := start + inventoryBatchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		payload := struct {
+			Reason string                `json:"reason,omitempty"`
+			Items  []InventoryAdjustment `json:"items"`
+		}{reason, items[start:end]}
+		var ret struct {
+			TransactionID string `json:"transaction_id"`
+		}
+		if err := bc.sendJSON(method, path, payload, &ret); err != nil {
+			return ids, err
+		}
+		ids = append(ids, ret.TransactionID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,218 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Iterator streams the items of a paginated v3 list endpoint, fetching one page at a time
+// so memory stays bounded for large catalogs. Use it like bufio.Scanner:
+//
+//	it := bc.IterateProducts(nil).Limit(250)
+//	for it.Next() {
+//		p := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		// it.Page() is the page to resume from with StartAt
+//	}
+type Iterator[T any] struct {
+	bc         *Client
+	path       string
+	query      url.Values
+	page       int
+	items      []T
+	pos        int
+	item       T
+	pagination Pagination
+	done       bool
+	err        error
+	fetch      func(page int) ([]T, Pagination, error)
+}
+
+// NewIterator returns an iterator over any v3 list endpoint that reports meta.pagination
+// path: the endpoint, e.g. /v3/catalog/products
+// args: additional query arguments to pass to the API, may be nil
+func NewIterator[T any](bc *Client, path string, args map[string]string) *Iterator[T] {
+	return newIterator[T](bc, path, argsQuery(args))
+}
+
+func newIterator[T any](bc *Client, path string, query url.Values) *Iterator[T] {
+	it := &Iterator[T]{
+		bc:    bc,
+		path:  path,
+		query: query,
+		page:  1,
+	}
+	it.fetch = func(page int) ([]T, Pagination, error) {
+		return getPage[T](bc, path, it.query, page)
+	}
+	if p, err := strconv.Atoi(query.Get("page")); err == nil && p > 0 {
+		it.page = p
+	}
+	query.Del("page")
+	return it
+}
+
+// Limit sets the page size (BigCommerce allows up to 250, default 50)
+func (it *Iterator[T]) Limit(limit int) *Iterator[T] {
+	it.query.Set("limit", strconv.Itoa(limit))
+	return it
+}
+
+// StartAt resumes the iteration from the given page number
+func (it *Iterator[T]) StartAt(page int) *Iterator[T] {
+	if page > 0 {
+		it.page = page
+	}
+	return it
+}
+
+// Next advances to the next item, fetching the next page when needed
+// returns false when there are no more items, the client's context is done, or on error
+func (it *Iterator[T]) Next() bool {
+	for it.pos >= len(it.items) {
+		if it.done || it.err != nil {
+			return false
+		}
+		if err := it.bc.Context().Err(); err != nil {
+			it.err = err
+			return false
+		}
+		items, pagination, err := it.fetch(it.page)
+		if err == ErrNoContent {
+			it.done = true
+			return false
+		}
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items, it.pos, it.pagination = items, 0, pagination
+		it.page++
+		if len(items) == 0 || pagination.CurrentPage >= pagination.TotalPages {
+			it.done = true
+		}
+	}
+	it.item = it.items[it.pos]
+	it.pos++
+	return true
+}
+
+// Item returns the current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, nil if it ran to the end or was stopped
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Page returns the number of the page that will be fetched next,
+// after an error it's the page that failed
+func (it *Iterator[T]) Page() int {
+	return it.page
+}
+
+// Pagination returns the pagination meta of the last fetched page
+func (it *Iterator[T]) Pagination() Pagination {
+	return it.pagination
+}
+
+// Stop ends the iteration early, Next returns false afterwards
+func (it *Iterator[T]) Stop() {
+	it.done = true
+	it.items, it.pos = nil, 0
+}
+
+// All collects the remaining items, returning the ones read so far with the error, if any
+func (it *Iterator[T]) All() ([]T, error) {
+	ret := []T{}
+	for it.Next() {
+		ret = append(ret, it.Item())
+	}
+	return ret, it.Err()
+}
+
+// getPage downloads a page of a v3 list endpoint
+func getPage[T any](bc *Client, path string, query url.Values, page int) ([]T, Pagination, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("page", strconv.Itoa(page))
+	req := bc.getAPIRequest(http.MethodGet, path+"?"+encodeQuery(q), nil)
+	res, err := bc.do(req)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer res.Body.Close()
+	body, err := processBody(res)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	var pp struct {
+		Data []T `json:"data"`
+		Meta struct {
+			Pagination Pagination `json:"pagination"`
+		} `json:"meta"`
+	}
+	err = json.Unmarshal(body, &pp)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	return pp.Data, pp.Meta.Pagination, nil
+}
+
+// newV2Iterator returns an iterator over a v2 list endpoint, which has no pagination meta:
+// pages are fetched until a short, empty or 204 page
+func newV2Iterator[T any](bc *Client, path string, query url.Values) *Iterator[T] {
+	it := newIterator[T](bc, path, query)
+	it.fetch = func(page int) ([]T, Pagination, error) {
+		return getV2Page[T](bc, path, it.query, page)
+	}
+	return it
+}
+
+// getV2Page downloads a page of a v2 list endpoint, the returned pagination only tells if
+// there may be more pages: TotalPages is CurrentPage+1 unless the page is short
+func getV2Page[T any](bc *Client, path string, query url.Values, page int) ([]T, Pagination, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("page", strconv.Itoa(page))
+	var items []T
+	err := bc.sendJSON(http.MethodGet, path+"?"+encodeQuery(q), nil, &items)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		limit = 50
+	}
+	p := Pagination{Count: len(items), CurrentPage: page, PerPage: limit, TotalPages: page}
+	if len(items) >= limit {
+		p.TotalPages = page + 1
+	}
+	return items, p, nil
+}
+
+// encodeQuery encodes the query, keeping ':' and ',' readable as in id:in=1,2,3
+func encodeQuery(q url.Values) string {
+	return strings.NewReplacer("%3A", ":", "%2C", ",").Replace(q.Encode())
+}
+
+// argsQuery converts the args map of the list functions to url.Values
+func argsQuery(args map[string]string) url.Values {
+	q := url.Values{}
+	for k, v := range args {
+		q.Set(k, v)
+	}
+	return q
+}
@@ -0,0 +1,205 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Logger receives the client's log messages with key-value pairs of fields
+// (method, path, status, duration, request_id, ...). *slog.Logger satisfies it.
+// Secrets like X-Auth-Token are never logged, request and response bodies only when
+// Client.LogBodies is set, with passwords, tokens and customer form field values redacted.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// LogLevel is the minimum level for StdLogger
+type LogLevel int
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	}
+	return "ERROR"
+}
+
+// StdLogger is a Logger writing key=value lines to a standard library *log.Logger
+type StdLogger struct {
+	Logger *log.Logger // log.Default() if nil
+	Level  LogLevel
+}
+
+// NewStdLogger returns a Logger writing messages of level or above to l
+func NewStdLogger(l *log.Logger, level LogLevel) *StdLogger {
+	return &StdLogger{Logger: l, Level: level}
+}
+
+func (s *StdLogger) Debug(msg string, kv ...interface{}) { s.log(LevelDebug, msg, kv) }
+func (s *StdLogger) Info(msg string, kv ...interface{})  { s.log(LevelInfo, msg, kv) }
+func (s *StdLogger) Warn(msg string, kv ...interface{})  { s.log(LevelWarn, msg, kv) }
+func (s *StdLogger) Error(msg string, kv ...interface{}) { s.log(LevelError, msg, kv) }
+
+func (s *StdLogger) log(level LogLevel, msg string, kv []interface{}) {
+	if level < s.Level {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(level.String())
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i < len(kv); i += 2 {
+		b.WriteString(" ")
+		if i+1 < len(kv) {
+			fmt.Fprintf(&b, "%v=%q", kv[i], fmt.Sprint(kv[i+1]))
+		} else {
+			fmt.Fprintf(&b, "%v", kv[i])
+		}
+	}
+	l := s.Logger
+	if l == nil {
+		l = log.Default()
+	}
+	l.Print(b.String())
+}
+
+// nopLogger discards everything, it's the default so no customer data ends up in logs by accident
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+func (bc *Client) logger() Logger {
+	if bc.Logger != nil {
+		return bc.Logger
+	}
+	return nopLogger{}
+}
+
+func (a *App) logger() Logger {
+	if a.Logger != nil {
+		return a.Logger
+	}
+	return nopLogger{}
+}
+
+// logError returns the cause of a transport error without its *url.Error wrapper,
+// whose text has the full URL with query arguments such as customer emails
+func logError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// logResponse logs a finished request: debug for success, warn for error responses
+func logResponse(l Logger, req *http.Request, res *http.Response, start time.Time, attempts int) {
+	fields := []interface{}{
+		"method", req.Method,
+		"path", apiPath(req),
+		"status", res.StatusCode,
+		"duration", time.Since(start),
+		"request_id", res.Header.Get("X-Request-Id"),
+	}
+	if attempts > 1 {
+		fields = append(fields, "attempts", attempts)
+	}
+	if res.StatusCode > 299 && res.StatusCode != http.StatusNotFound {
+		l.Warn("bigcommerce request failed", fields...)
+		return
+	}
+	l.Debug("bigcommerce request", fields...)
+}
+
+// logBodies logs the redacted request and response bodies, the response body is buffered and replaced
+func logBodies(l Logger, req *http.Request, res *http.Response) {
+	path := apiPath(req)
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := ioutil.ReadAll(body)
+			body.Close()
+			l.Debug("bigcommerce request body", "method", req.Method, "path", path, "body", redactBody(path, b))
+		}
+	}
+	if res == nil || res.Body == nil {
+		return
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return
+	}
+	l.Debug("bigcommerce response body", "method", req.Method, "path", path, "status", res.StatusCode, "body", redactBody(path, b))
+}
+
+const redacted = "[REDACTED]"
+
+// JSON keys whose values are replaced before logging
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"new_password":  true,
+	"access_token":  true,
+	"client_secret": true,
+	"x-auth-token":  true,
+	"form_fields":   true,
+}
+
+// redactBody returns the body for logging with secrets and customer form fields replaced
+func redactBody(path string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.Contains(path, "form-field-values") {
+		return redacted
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return redacted
+	}
+	b, _ := json.Marshal(redactValue(v))
+	return string(b)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if sensitiveKeys[strings.ToLower(k)] {
+				t[k] = redacted
+			} else {
+				t[k] = redactValue(val)
+			}
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = redactValue(t[i])
+		}
+	}
+	return v
+}
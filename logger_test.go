@@ -0,0 +1,32 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestTransportErrorLogOmitsQuery(t *testing.T) {
+	var buf bytes.Buffer
+	bc := NewClient("store", "token")
+	bc.MaxRetries = 0
+	bc.Logger = NewStdLogger(log.New(&buf, "", 0), LevelDebug)
+	bc.HTTPClient = &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})}
+
+	if _, err := bc.GetCustomerByEmail("jane@example.com"); err == nil {
+		t.Fatal("expected an error")
+	}
+	out := buf.String()
+	if strings.Contains(out, "jane") || !strings.Contains(out, "connection refused") {
+		t.Errorf("log = %q, want the cause without the query", out)
+	}
+}
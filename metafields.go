@@ -0,0 +1,300 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// permission sets of a Metafield, who besides the app that created it can read or write it
+const (
+	MetafieldAppOnly          = "app_only"            // only the app that created it
+	MetafieldRead             = "read"                // other apps can read it
+	MetafieldWrite            = "write"               // other apps can read and write it
+	MetafieldReadAndSFAccess  = "read_and_sf_access"  // read, and exposed to the storefront
+	MetafieldWriteAndSFAccess = "write_and_sf_access" // write, and exposed to the storefront
+)
+
+// metafieldBatchSize is the maximum number of metafields per batch request
+const metafieldBatchSize = 50
+
+// MetafieldResourceType is the kind of resource a metafield is attached to
+type MetafieldResourceType string
+
+// resource types that have metafields
+const (
+	MetafieldProduct  MetafieldResourceType = "product"
+	MetafieldVariant  MetafieldResourceType = "variant"
+	MetafieldCategory MetafieldResourceType = "category"
+	MetafieldBrand    MetafieldResourceType = "brand"
+	MetafieldOrder    MetafieldResourceType = "order"
+	MetafieldCustomer MetafieldResourceType = "customer"
+	MetafieldCart     MetafieldResourceType = "cart"
+	MetafieldChannel  MetafieldResourceType = "channel"
+)
+
+// batch endpoints of each resource type
+var metafieldBatchPaths = map[MetafieldResourceType]string{
+	MetafieldProduct:  "/v3/catalog/products/metafields",
+	MetafieldVariant:  "/v3/catalog/variants/metafields",
+	MetafieldCategory: "/v3/catalog/categories/metafields",
+	MetafieldBrand:    "/v3/catalog/brands/metafields",
+	MetafieldOrder:    "/v3/orders/metafields",
+	MetafieldCustomer: "/v3/customers/metafields",
+	MetafieldChannel:  "/v3/channels/metafields",
+}
+
+// Metafield is a struct representing a BigCommerce metafield, a namespaced key-value pair
+// an app stores on a resource
+type Metafield struct {
+	ID            int64     `json:"id,omitempty"`
+	Key           string    `json:"key,omitempty"`
+	Value         string    `json:"value"`
+	ResourceID    int64     `json:"resource_id,omitempty"`
+	ResourceType  string    `json:"resource_type,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	DateCreated   time.Time `json:"date_created,omitempty"`
+	DateModified  time.Time `json:"date_modified,omitempty"`
+	Namespace     string    `json:"namespace,omitempty"`
+	PermissionSet string    `json:"permission_set,omitempty"` // one of the Metafield* permission set constants
+	CartID        string    `json:"-"`                        // resource ID of cart metafields, carts have UUIDs
+}
+
+// UnmarshalJSON reads resource_id into CartID when it's a string, as for cart metafields
+func (m *Metafield) UnmarshalJSON(b []byte) error {
+	type metafield Metafield
+	aux := struct {
+		*metafield
+		ResourceID json.RawMessage `json:"resource_id,omitempty"`
+	}{metafield: (*metafield)(m)}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	if len(aux.ResourceID) == 0 || string(aux.ResourceID) == "null" {
+		return nil
+	}
+	if aux.ResourceID[0] == '"' {
+		return json.Unmarshal(aux.ResourceID, &m.CartID)
+	}
+	return json.Unmarshal(aux.ResourceID, &m.ResourceID)
+}
+
+// metafieldPayload holds the writable fields of a Metafield
+type metafieldPayload struct {
+	ID            int64  `json:"id,omitempty"`
+	ResourceID    int64  `json:"resource_id,omitempty"`
+	Namespace     string `json:"namespace,omitempty"`
+	Key           string `json:"key,omitempty"`
+	Value         string `json:"value"`
+	Description   string `json:"description,omitempty"`
+	PermissionSet string `json:"permission_set,omitempty"`
+}
+
+func (m *Metafield) payload() metafieldPayload {
+	return metafieldPayload{
+		ID:            m.ID,
+		ResourceID:    m.ResourceID,
+		Namespace:     m.Namespace,
+		Key:           m.Key,
+		Value:         m.Value,
+		Description:   m.Description,
+		PermissionSet: m.PermissionSet,
+	}
+}
+
+// MetafieldResource addresses the metafields of a single resource,
+// create it with ProductMetafields, VariantMetafields, CategoryMetafields, etc.
+type MetafieldResource struct {
+	Type MetafieldResourceType
+	path string
+}
+
+// ProductMetafields addresses the metafields of a product
+func ProductMetafields(productID int64) MetafieldResource {
+	return MetafieldResource{MetafieldProduct, "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/metafields"}
+}
+
+// VariantMetafields addresses the metafields of a product variant
+func VariantMetafields(productID, variantID int64) MetafieldResource {
+	return MetafieldResource{MetafieldVariant, variantsPath(productID) + "/" + strconv.FormatInt(variantID, 10) + "/metafields"}
+}
+
+// CategoryMetafields addresses the metafields of a category
+func CategoryMetafields(categoryID int64) MetafieldResource {
+	return MetafieldResource{MetafieldCategory, "/v3/catalog/categories/" + strconv.FormatInt(categoryID, 10) + "/metafields"}
+}
+
+// BrandMetafields addresses the metafields of a brand
+func BrandMetafields(brandID int64) MetafieldResource {
+	return MetafieldResource{MetafieldBrand, "/v3/catalog/brands/" + strconv.FormatInt(brandID, 10) + "/metafields"}
+}
+
+// OrderMetafields addresses the metafields of an order
+func OrderMetafields(orderID int64) MetafieldResource {
+	return MetafieldResource{MetafieldOrder, "/v3/orders/" + strconv.FormatInt(orderID, 10) + "/metafields"}
+}
+
+// CustomerMetafields addresses the metafields of a customer
+func CustomerMetafields(customerID int64) MetafieldResource {
+	return MetafieldResource{MetafieldCustomer, "/v3/customers/" + strconv.FormatInt(customerID, 10) + "/metafields"}
+}
+
+// CartMetafields addresses the metafields of a cart
+func CartMetafields(cartID string) MetafieldResource {
+	return MetafieldResource{MetafieldCart, "/v3/carts/" + cartID + "/metafields"}
+}
+
+// ChannelMetafields addresses the metafields of a channel
+func ChannelMetafields(channelID int64) MetafieldResource {
+	return MetafieldResource{MetafieldChannel, "/v3/channels/" + strconv.FormatInt(channelID, 10) + "/metafields"}
+}
+
+// GetMetafields gets all metafields of a resource
+// args is a key-value map of additional arguments to pass to the API, e.g. namespace or key
+func (bc *Client) GetMetafields(r MetafieldResource, args map[string]string) ([]Metafield, error) {
+	return NewIterator[Metafield](bc, r.path, args).All()
+}
+
+// GetMetafield gets the metafield of a resource with the given namespace and key
+// returns ErrNotFound if there is none
+func (bc *Client) GetMetafield(r MetafieldResource, namespace, key string) (*Metafield, error) {
+	mfs, err := bc.GetMetafields(r, map[string]string{"namespace": namespace, "key": key})
+	if err != nil {
+		return nil, err
+	}
+	for _, mf := range mfs {
+		if mf.Namespace == namespace && mf.Key == key {
+			return &mf, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// CreateMetafield creates a metafield on a resource, Namespace, Key, Value and PermissionSet are required
+func (bc *Client) CreateMetafield(r MetafieldResource, mf *Metafield) (*Metafield, error) {
+	p := mf.payload()
+	p.ID, p.ResourceID = 0, 0
+	var ret Metafield
+	err := bc.sendData(http.MethodPost, r.path, p, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateMetafield updates a metafield of a resource by ID and returns the updated metafield
+func (bc *Client) UpdateMetafield(r MetafieldResource, metafieldID int64, mf *Metafield) (*Metafield, error) {
+	p := mf.payload()
+	p.ID, p.ResourceID = 0, 0
+	var ret Metafield
+	err := bc.sendData(http.MethodPut, r.path+"/"+strconv.FormatInt(metafieldID, 10), p, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// SetMetafield creates the metafield with mf's Namespace and Key on a resource, or updates it if it exists
+func (bc *Client) SetMetafield(r MetafieldResource, mf *Metafield) (*Metafield, error) {
+	existing, err := bc.GetMetafield(r, mf.Namespace, mf.Key)
+	if IsNotFound(err) {
+		return bc.CreateMetafield(r, mf)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bc.UpdateMetafield(r, existing.ID, mf)
+}
+
+// DeleteMetafield deletes a metafield of a resource by ID
+func (bc *Client) DeleteMetafield(r MetafieldResource, metafieldID int64) error {
+	return bc.sendJSON(http.MethodDelete, r.path+"/"+strconv.FormatInt(metafieldID, 10), nil, nil)
+}
+
+// DeleteMetafieldByKey deletes the metafield of a resource with the given namespace and key,
+// it is not an error if there is none
+func (bc *Client) DeleteMetafieldByKey(r MetafieldResource, namespace, key string) error {
+	mf, err := bc.GetMetafield(r, namespace, key)
+	if IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return bc.DeleteMetafield(r, mf.ID)
+}
+
+// metafieldBatchPath returns the batch endpoint of a resource type, carts have none
+func metafieldBatchPath(t MetafieldResourceType) (string, error) {
+	path, ok := metafieldBatchPaths[t]
+	if !ok {
+		return "", fmt.Errorf("batch metafield requests are not supported for resource type %q", t)
+	}
+	return path, nil
+}
+
+// GetAllMetafields gets the metafields of all resources of a type, e.g. all product metafields
+// args is a key-value map of additional arguments to pass to the API, e.g. namespace or key
+func (bc *Client) GetAllMetafields(t MetafieldResourceType, args map[string]string) ([]Metafield, error) {
+	path, err := metafieldBatchPath(t)
+	if err != nil {
+		return nil, err
+	}
+	return NewIterator[Metafield](bc, path, args).All()
+}
+
+// CreateMetafields creates metafields on resources of a type in batches of 50, each must have a ResourceID
+// failing metafields are reported in a *BatchError with their ResourceID, the others are still created
+func (bc *Client) CreateMetafields(t MetafieldResourceType, mfs []Metafield) ([]Metafield, error) {
+	for _, mf := range mfs {
+		if mf.ResourceID == 0 {
+			return nil, errors.New("metafield resource ID is required")
+		}
+	}
+	return bc.metafieldBatch(t, http.MethodPost, mfs, func(mf Metafield) int64 { return mf.ResourceID })
+}
+
+// UpdateMetafields updates metafields of resources of a type in batches of 50, each must have an ID
+// failing metafields are reported in a *BatchError, the others are still updated
+func (bc *Client) UpdateMetafields(t MetafieldResourceType, mfs []Metafield) ([]Metafield, error) {
+	for _, mf := range mfs {
+		if mf.ID == 0 {
+			return nil, errors.New("metafield ID is required")
+		}
+	}
+	return bc.metafieldBatch(t, http.MethodPut, mfs, func(mf Metafield) int64 { return mf.ID })
+}
+
+// DeleteMetafields deletes metafields of resources of a type by ID, in batches of 50
+func (bc *Client) DeleteMetafields(t MetafieldResourceType, metafieldIDs []int64) error {
+	path, err := metafieldBatchPath(t)
+	if err != nil {
+		return err
+	}
+	_, err = batchUpdate(metafieldIDs, metafieldBatchSize,
+		func(id int64) int64 { return id },
+		func(batch []int64) ([]int64, error) {
+			return batch, bc.sendJSON(http.MethodDelete, path, batch, nil)
+		})
+	return err
+}
+
+func (bc *Client) metafieldBatch(t MetafieldResourceType, method string, mfs []Metafield, id func(Metafield) int64) ([]Metafield, error) {
+	path, err := metafieldBatchPath(t)
+	if err != nil {
+		return nil, err
+	}
+	return batchUpdate(mfs, metafieldBatchSize, id,
+		func(batch []Metafield) ([]Metafield, error) {
+			payload := make([]metafieldPayload, len(batch))
+			for i := range batch {
+				payload[i] = batch[i].payload()
+			}
+			var ret []Metafield
+			err := bc.sendData(method, path, payload, &ret)
+			return ret, err
+		})
+}
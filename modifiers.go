@@ -0,0 +1,162 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// adjuster types of a ModifierValue price or weight adjuster
+const (
+	AdjusterRelative   = "relative"
+	AdjusterPercentage = "percentage"
+)
+
+// Modifier is a product option that does not create variants, e.g. a gift message text field
+// or a checkbox that adds to the price
+type Modifier struct {
+	ID           int64           `json:"id,omitempty"`
+	ProductID    int64           `json:"product_id,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	DisplayName  string          `json:"display_name,omitempty"`
+	Type         string          `json:"type,omitempty"` // one of the OptionType* constants
+	Required     bool            `json:"required"`
+	SortOrder    int             `json:"sort_order,omitempty"`
+	Config       *OptionConfig   `json:"config,omitempty"`
+	OptionValues []ModifierValue `json:"option_values,omitempty"`
+}
+
+// ModifierPayload is the body of CreateModifier and UpdateModifier, only the non-nil fields are sent
+// DisplayName, Type and Required are required to create a modifier
+type ModifierPayload struct {
+	Name         *string         `json:"name,omitempty"`
+	DisplayName  *string         `json:"display_name,omitempty"`
+	Type         *string         `json:"type,omitempty"` // one of the OptionType* constants
+	Required     *bool           `json:"required,omitempty"`
+	SortOrder    *int            `json:"sort_order,omitempty"`
+	Config       *OptionConfig   `json:"config,omitempty"`
+	OptionValues []ModifierValue `json:"option_values,omitempty"`
+}
+
+// ModifierValue is a value of a modifier, with the adjustments it makes to the product
+type ModifierValue struct {
+	ID        int64      `json:"id,omitempty"`
+	OptionID  int64      `json:"option_id,omitempty"`
+	Label     string     `json:"label,omitempty"`
+	SortOrder int        `json:"sort_order,omitempty"`
+	IsDefault bool       `json:"is_default,omitempty"`
+	ValueData *ValueData `json:"value_data,omitempty"`
+	Adjusters *Adjusters `json:"adjusters,omitempty"`
+}
+
+// Adjusters are the changes a modifier value makes to the product when selected
+type Adjusters struct {
+	Price              *Adjuster                   `json:"price,omitempty"`
+	Weight             *Adjuster                   `json:"weight,omitempty"`
+	ImageURL           string                      `json:"image_url,omitempty"`
+	PurchasingDisabled *PurchasingDisabledAdjuster `json:"purchasing_disabled,omitempty"`
+}
+
+// Adjuster changes the price or weight by AdjusterValue, either relative (an amount) or percentage
+type Adjuster struct {
+	Adjuster      string  `json:"adjuster,omitempty"` // AdjusterRelative or AdjusterPercentage
+	AdjusterValue Decimal `json:"adjuster_value"`
+}
+
+// PurchasingDisabledAdjuster disables purchasing of the product when the value is selected
+type PurchasingDisabledAdjuster struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+func modifiersPath(productID int64) string {
+	return "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/modifiers"
+}
+
+func modifierValuesPath(productID, modifierID int64) string {
+	return modifiersPath(productID) + "/" + strconv.FormatInt(modifierID, 10) + "/values"
+}
+
+// GetModifiers gets all modifiers of a product
+// productID: BigCommerce product ID
+func (bc *Client) GetModifiers(productID int64) ([]Modifier, error) {
+	return NewIterator[Modifier](bc, modifiersPath(productID), nil).All()
+}
+
+// GetModifier gets a modifier of a product by ID
+func (bc *Client) GetModifier(productID, modifierID int64) (*Modifier, error) {
+	var ret Modifier
+	err := bc.sendData(http.MethodGet, modifiersPath(productID)+"/"+strconv.FormatInt(modifierID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateModifier creates a modifier of a product with its values
+func (bc *Client) CreateModifier(productID int64, payload *ModifierPayload) (*Modifier, error) {
+	if payload.DisplayName == nil || payload.Type == nil || payload.Required == nil {
+		return nil, errors.New("modifier display name, type and required are required")
+	}
+	var ret Modifier
+	err := bc.sendData(http.MethodPost, modifiersPath(productID), payload, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateModifier updates the non-nil fields of a modifier of a product and returns the updated modifier
+func (bc *Client) UpdateModifier(productID, modifierID int64, patch *ModifierPayload) (*Modifier, error) {
+	var ret Modifier
+	err := bc.sendData(http.MethodPut, modifiersPath(productID)+"/"+strconv.FormatInt(modifierID, 10), patch, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteModifier deletes a modifier of a product
+func (bc *Client) DeleteModifier(productID, modifierID int64) error {
+	return bc.sendJSON(http.MethodDelete, modifiersPath(productID)+"/"+strconv.FormatInt(modifierID, 10), nil, nil)
+}
+
+// GetModifierValues gets all values of a modifier
+func (bc *Client) GetModifierValues(productID, modifierID int64) ([]ModifierValue, error) {
+	return NewIterator[ModifierValue](bc, modifierValuesPath(productID, modifierID), nil).All()
+}
+
+// GetModifierValue gets a value of a modifier by ID
+func (bc *Client) GetModifierValue(productID, modifierID, valueID int64) (*ModifierValue, error) {
+	var ret ModifierValue
+	err := bc.sendData(http.MethodGet, modifierValuesPath(productID, modifierID)+"/"+strconv.FormatInt(valueID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateModifierValue adds a value to a modifier
+func (bc *Client) CreateModifierValue(productID, modifierID int64, value *ModifierValue) (*ModifierValue, error) {
+	var ret ModifierValue
+	err := bc.sendData(http.MethodPost, modifierValuesPath(productID, modifierID), value, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateModifierValue updates a value of a modifier and returns the updated value
+func (bc *Client) UpdateModifierValue(productID, modifierID, valueID int64, value *ModifierValue) (*ModifierValue, error) {
+	var ret ModifierValue
+	err := bc.sendData(http.MethodPut, modifierValuesPath(productID, modifierID)+"/"+strconv.FormatInt(valueID, 10), value, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteModifierValue deletes a value of a modifier
+func (bc *Client) DeleteModifierValue(productID, modifierID, valueID int64) error {
+	return bc.sendJSON(http.MethodDelete, modifierValuesPath(productID, modifierID)+"/"+strconv.FormatInt(valueID, 10), nil, nil)
+}
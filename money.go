@@ -0,0 +1,102 @@
+package bigcommerce
+
+import "errors"
+
+// ErrCurrencyMismatch is returned when adding or subtracting amounts of different currencies
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money is an amount in a currency, use Order.Money and Cart.Money to get
+// the amounts of an order or a cart with their currency
+type Money struct {
+	Amount   Decimal
+	Currency string // ISO currency code, e.g. USD, empty if unknown
+}
+
+// NewMoney returns amount in the given currency
+func NewMoney(amount Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Money returns an amount of the order in the order's transactional currency, e.g. o.Money(o.TotalIncTax)
+func (o *Order) Money(amount Decimal) Money {
+	return Money{Amount: amount, Currency: o.CurrencyCode}
+}
+
+// Money returns an amount of the cart in the cart's currency, e.g. c.Money(c.CartAmount)
+func (c *Cart) Money(amount Decimal) Money {
+	return Money{Amount: amount, Currency: c.Currency.Code}
+}
+
+// currency returns the common currency of m and o, an empty currency matches any
+func (m Money) currency(o Money) (string, error) {
+	switch {
+	case m.Currency == o.Currency || o.Currency == "":
+		return m.Currency, nil
+	case m.Currency == "":
+		return o.Currency, nil
+	}
+	return "", ErrCurrencyMismatch
+}
+
+// Add returns m + o, ErrCurrencyMismatch if they have different currencies
+func (m Money) Add(o Money) (Money, error) {
+	c, err := m.currency(o)
+	if err != nil {
+		return m, err
+	}
+	return Money{Amount: m.Amount.Add(o.Amount), Currency: c}, nil
+}
+
+// Sub returns m - o, ErrCurrencyMismatch if they have different currencies
+func (m Money) Sub(o Money) (Money, error) {
+	c, err := m.currency(o)
+	if err != nil {
+		return m, err
+	}
+	return Money{Amount: m.Amount.Sub(o.Amount), Currency: c}, nil
+}
+
+// MulInt returns m * n, e.g. a unit price times a quantity
+func (m Money) MulInt(n int64) Money {
+	return Money{Amount: m.Amount.MulInt(n), Currency: m.Currency}
+}
+
+// Mul returns m * d rounded to 6 fractional digits, e.g. a price times a tax rate
+func (m Money) Mul(d Decimal) Money {
+	return Money{Amount: m.Amount.Mul(d), Currency: m.Currency}
+}
+
+// Round rounds m half away from zero to the given number of fractional digits
+func (m Money) Round(places int) Money {
+	return Money{Amount: m.Amount.Round(places), Currency: m.Currency}
+}
+
+// RoundTo rounds m to the decimal places of the currency, e.g. 2 for USD, 0 for JPY
+func (m Money) RoundTo(c Currency) Money {
+	return m.Round(c.DecimalPlaces)
+}
+
+// IsZero tells if the amount is 0
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// String returns the amount and the currency code, e.g. 12.5 USD
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+	return m.Amount.String() + " " + m.Currency
+}
+
+// SumMoney adds up amounts of the same currency
+func SumMoney(amounts ...Money) (Money, error) {
+	var total Money
+	for _, m := range amounts {
+		var err error
+		if total, err = total.Add(m); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,36 @@
+package bigcommerce
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoneyAdd(t *testing.T) {
+	tests := []struct {
+		a, b    Money
+		want    string
+		wantErr error
+	}{
+		{a: NewMoney(DecimalFromInt(1), "USD"), b: NewMoney(DecimalFromFloat(0.5), "USD"), want: "1.5 USD"},
+		{a: NewMoney(DecimalFromInt(1), "USD"), b: NewMoney(DecimalFromInt(2), ""), want: "3 USD"},
+		{a: NewMoney(DecimalFromInt(1), ""), b: NewMoney(DecimalFromInt(2), "EUR"), want: "3 EUR"},
+		{a: NewMoney(DecimalFromInt(1), "USD"), b: NewMoney(DecimalFromInt(2), "EUR"), wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		got, err := tt.a.Add(tt.b)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s + %s error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("%s + %s = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSumMoney(t *testing.T) {
+	_, err := SumMoney(NewMoney(DecimalFromInt(1), "USD"), NewMoney(DecimalFromInt(1), "EUR"))
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("SumMoney error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+}
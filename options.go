@@ -0,0 +1,189 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// option and modifier types
+const (
+	OptionTypeRadioButtons      = "radio_buttons"
+	OptionTypeRectangles        = "rectangles"
+	OptionTypeDropdown          = "dropdown"
+	OptionTypeProductList       = "product_list"
+	OptionTypeProductListImages = "product_list_with_images"
+	OptionTypeSwatch            = "swatch"
+	OptionTypeCheckbox          = "checkbox"          // modifiers only
+	OptionTypeDate              = "date"              // modifiers only
+	OptionTypeFile              = "file"              // modifiers only
+	OptionTypeText              = "text"              // modifiers only
+	OptionTypeMultiLineText     = "multi_line_text"   // modifiers only
+	OptionTypeNumbersOnlyText   = "numbers_only_text" // modifiers only
+)
+
+// VariantOption is an option of a product that its variants are built from, e.g. Color or Size.
+// Every combination of option values is a Variant
+type VariantOption struct {
+	ID           int64         `json:"id,omitempty"`
+	ProductID    int64         `json:"product_id,omitempty"`
+	DisplayName  string        `json:"display_name,omitempty"`
+	Type         string        `json:"type,omitempty"` // one of the OptionType* constants
+	Config       *OptionConfig `json:"config,omitempty"`
+	SortOrder    int           `json:"sort_order,omitempty"`
+	OptionValues []OptionValue `json:"option_values,omitempty"`
+	Name         string        `json:"name,omitempty"`
+}
+
+// VariantOptionPayload is the body of CreateProductOption and UpdateProductOption,
+// only the non-nil fields are sent
+// DisplayName and Type are required to create an option
+type VariantOptionPayload struct {
+	Name         *string       `json:"name,omitempty"`
+	DisplayName  *string       `json:"display_name,omitempty"`
+	Type         *string       `json:"type,omitempty"` // one of the OptionType* constants
+	SortOrder    *int          `json:"sort_order,omitempty"`
+	Config       *OptionConfig `json:"config,omitempty"`
+	OptionValues []OptionValue `json:"option_values,omitempty"`
+}
+
+// OptionValue is a value of a product option, e.g. "Red" of the option "Color"
+// OptionID and OptionDisplayName are set in the option values of a Variant
+type OptionValue struct {
+	ID                int64      `json:"id,omitempty"`
+	Label             string     `json:"label,omitempty"`
+	SortOrder         int        `json:"sort_order,omitempty"`
+	IsDefault         bool       `json:"is_default,omitempty"`
+	ValueData         *ValueData `json:"value_data,omitempty"`
+	OptionID          int64      `json:"option_id,omitempty"`
+	OptionDisplayName string     `json:"option_display_name,omitempty"`
+}
+
+// ValueData holds the type specific data of an option or modifier value
+type ValueData struct {
+	Colors       []string `json:"colors,omitempty"`        // swatch: up to 3 hex colors
+	ImageURL     string   `json:"image_url,omitempty"`     // swatch: pattern image
+	ProductID    int64    `json:"product_id,omitempty"`    // product_list
+	CheckedValue bool     `json:"checked_value,omitempty"` // checkbox
+}
+
+// OptionConfig is the type specific configuration of an option or modifier,
+// only the fields of the option's type are used
+type OptionConfig struct {
+	DefaultValue                string   `json:"default_value,omitempty"`
+	CheckedByDefault            bool     `json:"checked_by_default,omitempty"`
+	CheckboxLabel               string   `json:"checkbox_label,omitempty"`
+	DateLimited                 bool     `json:"date_limited,omitempty"`
+	DateLimitMode               string   `json:"date_limit_mode,omitempty"`
+	DateEarliestValue           string   `json:"date_earliest_value,omitempty"`
+	DateLatestValue             string   `json:"date_latest_value,omitempty"`
+	FileTypesMode               string   `json:"file_types_mode,omitempty"`
+	FileTypesSupported          []string `json:"file_types_supported,omitempty"`
+	FileTypesOther              []string `json:"file_types_other,omitempty"`
+	FileMaxSize                 int      `json:"file_max_size,omitempty"`
+	TextCharactersLimited       bool     `json:"text_characters_limited,omitempty"`
+	TextMinLength               int      `json:"text_min_length,omitempty"`
+	TextMaxLength               int      `json:"text_max_length,omitempty"`
+	TextLinesLimited            bool     `json:"text_lines_limited,omitempty"`
+	TextMaxLines                int      `json:"text_max_lines,omitempty"`
+	NumberLimited               bool     `json:"number_limited,omitempty"`
+	NumberLimitMode             string   `json:"number_limit_mode,omitempty"`
+	NumberLowestValue           float64  `json:"number_lowest_value,omitempty"`
+	NumberHighestValue          float64  `json:"number_highest_value,omitempty"`
+	NumberIntegersOnly          bool     `json:"number_integers_only,omitempty"`
+	ProductListAdjustsInventory bool     `json:"product_list_adjusts_inventory,omitempty"`
+	ProductListAdjustsPricing   bool     `json:"product_list_adjusts_pricing,omitempty"`
+	ProductListShippingCalc     string   `json:"product_list_shipping_calc,omitempty"`
+}
+
+func optionsPath(productID int64) string {
+	return "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/options"
+}
+
+func optionValuesPath(productID, optionID int64) string {
+	return optionsPath(productID) + "/" + strconv.FormatInt(optionID, 10) + "/values"
+}
+
+// GetProductOptions gets all variant options of a product
+// productID: BigCommerce product ID
+func (bc *Client) GetProductOptions(productID int64) ([]VariantOption, error) {
+	return NewIterator[VariantOption](bc, optionsPath(productID), nil).All()
+}
+
+// GetProductOption gets a variant option of a product by ID
+func (bc *Client) GetProductOption(productID, optionID int64) (*VariantOption, error) {
+	var ret VariantOption
+	err := bc.sendData(http.MethodGet, optionsPath(productID)+"/"+strconv.FormatInt(optionID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateProductOption creates a variant option of a product with its option values
+func (bc *Client) CreateProductOption(productID int64, payload *VariantOptionPayload) (*VariantOption, error) {
+	if payload.DisplayName == nil || payload.Type == nil {
+		return nil, errors.New("option display name and type are required")
+	}
+	var ret VariantOption
+	err := bc.sendData(http.MethodPost, optionsPath(productID), payload, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateProductOption updates the non-nil fields of a variant option of a product and returns the updated option
+func (bc *Client) UpdateProductOption(productID, optionID int64, patch *VariantOptionPayload) (*VariantOption, error) {
+	var ret VariantOption
+	err := bc.sendData(http.MethodPut, optionsPath(productID)+"/"+strconv.FormatInt(optionID, 10), patch, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteProductOption deletes a variant option of a product, along with the variants using it
+func (bc *Client) DeleteProductOption(productID, optionID int64) error {
+	return bc.sendJSON(http.MethodDelete, optionsPath(productID)+"/"+strconv.FormatInt(optionID, 10), nil, nil)
+}
+
+// GetOptionValues gets all values of a variant option
+func (bc *Client) GetOptionValues(productID, optionID int64) ([]OptionValue, error) {
+	return NewIterator[OptionValue](bc, optionValuesPath(productID, optionID), nil).All()
+}
+
+// GetOptionValue gets a value of a variant option by ID
+func (bc *Client) GetOptionValue(productID, optionID, valueID int64) (*OptionValue, error) {
+	var ret OptionValue
+	err := bc.sendData(http.MethodGet, optionValuesPath(productID, optionID)+"/"+strconv.FormatInt(valueID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateOptionValue adds a value to a variant option
+func (bc *Client) CreateOptionValue(productID, optionID int64, value *OptionValue) (*OptionValue, error) {
+	var ret OptionValue
+	err := bc.sendData(http.MethodPost, optionValuesPath(productID, optionID), value, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateOptionValue updates a value of a variant option and returns the updated value
+func (bc *Client) UpdateOptionValue(productID, optionID, valueID int64, value *OptionValue) (*OptionValue, error) {
+	var ret OptionValue
+	err := bc.sendData(http.MethodPut, optionValuesPath(productID, optionID)+"/"+strconv.FormatInt(valueID, 10), value, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteOptionValue deletes a value of a variant option
+func (bc *Client) DeleteOptionValue(productID, optionID, valueID int64) error {
+	return bc.sendJSON(http.MethodDelete, optionValuesPath(productID, optionID)+"/"+strconv.FormatInt(valueID, 10), nil, nil)
+}
@@ -2,10 +2,10 @@ package bigcommerce
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
-	"strings"
 )
 
 type Order struct {
@@ -16,36 +16,36 @@ type Order struct {
 	DateShipped                             string       `json:"date_shipped"`
 	StatusID                                int64        `json:"status_id"`
 	Status                                  string       `json:"status"`
-	SubtotalExTax                           string       `json:"subtotal_ex_tax"`
-	SubtotalIncTax                          string       `json:"subtotal_inc_tax"`
-	SubtotalTax                             string       `json:"subtotal_tax"`
-	BaseShippingCost                        string       `json:"base_shipping_cost"`
-	ShippingCostExTax                       string       `json:"shipping_cost_ex_tax"`
-	ShippingCostIncTax                      string       `json:"shipping_cost_inc_tax"`
-	ShippingCostTax                         string       `json:"shipping_cost_tax"`
+	SubtotalExTax                           Decimal      `json:"subtotal_ex_tax"`
+	SubtotalIncTax                          Decimal      `json:"subtotal_inc_tax"`
+	SubtotalTax                             Decimal      `json:"subtotal_tax"`
+	BaseShippingCost                        Decimal      `json:"base_shipping_cost"`
+	ShippingCostExTax                       Decimal      `json:"shipping_cost_ex_tax"`
+	ShippingCostIncTax                      Decimal      `json:"shipping_cost_inc_tax"`
+	ShippingCostTax                         Decimal      `json:"shipping_cost_tax"`
 	ShippingCostTaxClassID                  int64        `json:"shipping_cost_tax_class_id"`
-	BaseHandlingCost                        string       `json:"base_handling_cost"`
-	HandlingCostExTax                       string       `json:"handling_cost_ex_tax"`
-	HandlingCostIncTax                      string       `json:"handling_cost_inc_tax"`
-	HandlingCostTax                         string       `json:"handling_cost_tax"`
+	BaseHandlingCost                        Decimal      `json:"base_handling_cost"`
+	HandlingCostExTax                       Decimal      `json:"handling_cost_ex_tax"`
+	HandlingCostIncTax                      Decimal      `json:"handling_cost_inc_tax"`
+	HandlingCostTax                         Decimal      `json:"handling_cost_tax"`
 	HandlingCostTaxClassID                  int64        `json:"handling_cost_tax_class_id"`
-	BaseWrappingCost                        string       `json:"base_wrapping_cost"`
-	WrappingCostExTax                       string       `json:"wrapping_cost_ex_tax"`
-	WrappingCostIncTax                      string       `json:"wrapping_cost_inc_tax"`
-	WrappingCostTax                         string       `json:"wrapping_cost_tax"`
+	BaseWrappingCost                        Decimal      `json:"base_wrapping_cost"`
+	WrappingCostExTax                       Decimal      `json:"wrapping_cost_ex_tax"`
+	WrappingCostIncTax                      Decimal      `json:"wrapping_cost_inc_tax"`
+	WrappingCostTax                         Decimal      `json:"wrapping_cost_tax"`
 	WrappingCostTaxClassID                  int64        `json:"wrapping_cost_tax_class_id"`
-	TotalExTax                              string       `json:"total_ex_tax"`
-	TotalIncTax                             string       `json:"total_inc_tax"`
-	TotalTax                                string       `json:"total_tax"`
+	TotalExTax                              Decimal      `json:"total_ex_tax"`
+	TotalIncTax                             Decimal      `json:"total_inc_tax"`
+	TotalTax                                Decimal      `json:"total_tax"`
 	ItemsTotal                              int          `json:"items_total"`
 	ItemsShipped                            int          `json:"items_shipped"`
 	PaymentMethod                           string       `json:"payment_method"`
 	PaymentProviderID                       string       `json:"payment_provider_id"`
 	PaymentStatus                           string       `json:"payment_status"`
-	RefundedAmount                          string       `json:"refunded_amount"`
+	RefundedAmount                          Decimal      `json:"refunded_amount"`
 	OrderIsDigital                          bool         `json:"order_is_digital"`
-	StoreCreditAmount                       string       `json:"store_credit_amount"`
-	GiftCertificateAmount                   string       `json:"gift_certificate_amount"`
+	StoreCreditAmount                       Decimal      `json:"store_credit_amount"`
+	GiftCertificateAmount                   Decimal      `json:"gift_certificate_amount"`
 	IPAddress                               string       `json:"ip_address"`
 	IPAddressV6                             string       `json:"ip_address_v6"`
 	GeoipCountry                            string       `json:"geoip_country"`
@@ -57,8 +57,8 @@ type Order struct {
 	DefaultCurrencyCode                     string       `json:"default_currency_code"`
 	StaffNotes                              string       `json:"staff_notes"`
 	CustomerMessage                         string       `json:"customer_message"`
-	DiscountAmount                          string       `json:"discount_amount"`
-	CouponDiscount                          string       `json:"coupon_discount"`
+	DiscountAmount                          Decimal      `json:"discount_amount"`
+	CouponDiscount                          Decimal      `json:"coupon_discount"`
 	ShippingAddressCount                    int          `json:"shipping_address_count"`
 	IsDeleted                               bool         `json:"is_deleted"`
 	EbayOrderID                             string       `json:"ebay_order_id"`
@@ -82,19 +82,19 @@ type Order struct {
 }
 
 type OrderAddress struct {
-	FirstName   string        `json:"first_name"`
-	LastName    string        `json:"last_name"`
-	Company     string        `json:"company"`
-	Street1     string        `json:"street_1"`
-	Street2     string        `json:"street_2"`
-	City        string        `json:"city"`
-	State       string        `json:"state"`
-	Zip         string        `json:"zip"`
-	Country     string        `json:"country"`
-	CountryIso2 string        `json:"country_iso2"`
-	Phone       string        `json:"phone"`
-	Email       string        `json:"email"`
-	FormFields  []interface{} `json:"form_fields"`
+	FirstName   string        `json:"first_name,omitempty"`
+	LastName    string        `json:"last_name,omitempty"`
+	Company     string        `json:"company,omitempty"`
+	Street1     string        `json:"street_1,omitempty"`
+	Street2     string        `json:"street_2,omitempty"`
+	City        string        `json:"city,omitempty"`
+	State       string        `json:"state,omitempty"`
+	Zip         string        `json:"zip,omitempty"`
+	Country     string        `json:"country,omitempty"`
+	CountryIso2 string        `json:"country_iso2,omitempty"`
+	Phone       string        `json:"phone,omitempty"`
+	Email       string        `json:"email,omitempty"`
+	FormFields  []interface{} `json:"form_fields,omitempty"`
 }
 
 type OrderProduct struct {
@@ -108,32 +108,32 @@ type OrderProduct struct {
 	Sku                  string            `json:"sku"`
 	Upc                  string            `json:"upc"`
 	Type                 string            `json:"type"`
-	BasePrice            string            `json:"base_price"`
-	PriceExTax           string            `json:"price_ex_tax"`
-	PriceIncTax          string            `json:"price_inc_tax"`
-	PriceTax             string            `json:"price_tax"`
-	BaseTotal            string            `json:"base_total"`
-	TotalExTax           string            `json:"total_ex_tax"`
-	TotalIncTax          string            `json:"total_inc_tax"`
-	TotalTax             string            `json:"total_tax"`
+	BasePrice            Decimal           `json:"base_price"`
+	PriceExTax           Decimal           `json:"price_ex_tax"`
+	PriceIncTax          Decimal           `json:"price_inc_tax"`
+	PriceTax             Decimal           `json:"price_tax"`
+	BaseTotal            Decimal           `json:"base_total"`
+	TotalExTax           Decimal           `json:"total_ex_tax"`
+	TotalIncTax          Decimal           `json:"total_inc_tax"`
+	TotalTax             Decimal           `json:"total_tax"`
 	Weight               string            `json:"weight"`
 	Quantity             int               `json:"quantity"`
-	BaseCostPrice        string            `json:"base_cost_price"`
-	CostPriceIncTax      string            `json:"cost_price_inc_tax"`
-	CostPriceExTax       string            `json:"cost_price_ex_tax"`
-	CostPriceTax         string            `json:"cost_price_tax"`
+	BaseCostPrice        Decimal           `json:"base_cost_price"`
+	CostPriceIncTax      Decimal           `json:"cost_price_inc_tax"`
+	CostPriceExTax       Decimal           `json:"cost_price_ex_tax"`
+	CostPriceTax         Decimal           `json:"cost_price_tax"`
 	IsRefunded           bool              `json:"is_refunded"`
 	QuantityRefunded     int               `json:"quantity_refunded"`
-	RefundAmount         string            `json:"refund_amount"`
+	RefundAmount         Decimal           `json:"refund_amount"`
 	ReturnID             int64             `json:"return_id"`
 	WrappingName         string            `json:"wrapping_name"`
-	BaseWrappingCost     string            `json:"base_wrapping_cost"`
-	WrappingCostExTax    string            `json:"wrapping_cost_ex_tax"`
-	WrappingCostIncTax   string            `json:"wrapping_cost_inc_tax"`
-	WrappingCostTax      string            `json:"wrapping_cost_tax"`
+	BaseWrappingCost     Decimal           `json:"base_wrapping_cost"`
+	WrappingCostExTax    Decimal           `json:"wrapping_cost_ex_tax"`
+	WrappingCostIncTax   Decimal           `json:"wrapping_cost_inc_tax"`
+	WrappingCostTax      Decimal           `json:"wrapping_cost_tax"`
 	WrappingMessage      string            `json:"wrapping_message"`
 	QuantityShipped      int               `json:"quantity_shipped"`
-	FixedShippingCost    string            `json:"fixed_shipping_cost"`
+	FixedShippingCost    Decimal           `json:"fixed_shipping_cost"`
 	EbayItemID           string            `json:"ebay_item_id"`
 	EbayTransactionID    string            `json:"ebay_transaction_id"`
 	OptionSetID          int64             `json:"option_set_id"`
@@ -151,12 +151,13 @@ type OrderProduct struct {
 
 type ProductDiscount struct {
 	ID     string      `json:"id"`
-	Amount string      `json:"amount"`
+	Amount Decimal     `json:"amount"`
 	Name   string      `json:"name"`
 	Code   interface{} `json:"code"`
 	Target string      `json:"target"`
 }
 
+// ProductOption is an option value chosen for an order product
 type ProductOption struct {
 	ID                   int64  `json:"id"`
 	OptionID             int64  `json:"option_id"`
@@ -192,15 +193,15 @@ type OrderShippingAddress struct {
 	ItemsTotal             int           `json:"items_total"`
 	ItemsShipped           int           `json:"items_shipped"`
 	ShippingMethod         string        `json:"shipping_method"`
-	BaseCost               string        `json:"base_cost"`
-	CostExTax              string        `json:"cost_ex_tax"`
-	CostIncTax             string        `json:"cost_inc_tax"`
-	CostTax                string        `json:"cost_tax"`
+	BaseCost               Decimal       `json:"base_cost"`
+	CostExTax              Decimal       `json:"cost_ex_tax"`
+	CostIncTax             Decimal       `json:"cost_inc_tax"`
+	CostTax                Decimal       `json:"cost_tax"`
 	CostTaxClassID         int64         `json:"cost_tax_class_id"`
-	BaseHandlingCost       string        `json:"base_handling_cost"`
-	HandlingCostExTax      string        `json:"handling_cost_ex_tax"`
-	HandlingCostIncTax     string        `json:"handling_cost_inc_tax"`
-	HandlingCostTax        string        `json:"handling_cost_tax"`
+	BaseHandlingCost       Decimal       `json:"base_handling_cost"`
+	HandlingCostExTax      Decimal       `json:"handling_cost_ex_tax"`
+	HandlingCostIncTax     Decimal       `json:"handling_cost_inc_tax"`
+	HandlingCostTax        Decimal       `json:"handling_cost_tax"`
 	HandlingCostTaxClassID int64         `json:"handling_cost_tax_class_id"`
 	ShippingZoneID         int64         `json:"shipping_zone_id"`
 	ShippingZoneName       string        `json:"shipping_zone_name"`
@@ -209,45 +210,54 @@ type OrderShippingAddress struct {
 }
 
 type OrderCoupon struct {
-	ID       int64  `json:"id"`
-	CouponID int64  `json:"coupon_id"`
-	OrderID  int64  `json:"order_id"`
-	Code     string `json:"code"`
-	Amount   int    `json:"amount"`
-	Type     int    `json:"type"`
-	Discount int    `json:"discount"`
+	ID       int64   `json:"id"`
+	CouponID int64   `json:"coupon_id"`
+	OrderID  int64   `json:"order_id"`
+	Code     string  `json:"code"`
+	Amount   Decimal `json:"amount"`
+	Type     int     `json:"type"`
+	Discount Decimal `json:"discount"`
 }
 
-// GetOrders returns all orders using filters
-// filters: request query parameters for BigCommerce orders endpoint, for example {"customer_id": "41"}
+// GetOrders returns a single page of orders using filters, 50 by default, see GetAllOrders
+// filters: request query parameters for BigCommerce orders endpoint, for example {"customer_id": "41"},
+// or OrderFilter.Args()
 func (bc *Client) GetOrders(filters map[string]string) ([]Order, error) {
-	params := []string{}
-	for k, v := range filters {
-		params = append(params, fmt.Sprintf("%s=%s", k, v))
+	var orders []Order
+	err := bc.sendJSON(http.MethodGet, "/v2/orders?"+encodeQuery(argsQuery(filters)), nil, &orders)
+	if err == ErrNoContent {
+		return []Order{}, nil
 	}
-	url := "/v2/orders?" + strings.Join(params, "&")
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	return orders, nil
+}
 
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		if res.StatusCode == http.StatusNoContent {
-			return []Order{}, nil
-		}
-		return nil, err
-	}
+// GetAllOrders returns all orders matching filters, handling pagination
+// filters: request query parameters for BigCommerce orders endpoint, or OrderFilter.Args()
+func (bc *Client) GetAllOrders(filters map[string]string) ([]Order, error) {
+	return bc.IterateOrders(filters).All()
+}
 
-	var orders []Order
-	err = json.Unmarshal(body, &orders)
-	if err != nil {
-		return nil, err
+// IterateOrders returns an iterator over the orders matching filters, fetching them page by page
+// until an empty page. Iterator.Pagination only tells if there may be a next page
+// filters: request query parameters for BigCommerce orders endpoint, or OrderFilter.Args()
+func (bc *Client) IterateOrders(filters map[string]string) *Iterator[Order] {
+	return newV2Iterator[Order](bc, "/v2/orders", argsQuery(filters))
+}
+
+// OrderCount returns the number of orders matching filters
+// filters: request query parameters for BigCommerce orders endpoint, or OrderFilter.Args()
+func (bc *Client) OrderCount(filters map[string]string) (int, error) {
+	var ret struct {
+		Count int `json:"count"`
 	}
-	return orders, nil
+	err := bc.sendJSON(http.MethodGet, "/v2/orders/count?"+encodeQuery(argsQuery(filters)), nil, &ret)
+	if err == ErrNoContent {
+		return 0, nil
+	}
+	return ret.Count, err
 }
 
 // GetOrder returns a given order
@@ -256,7 +266,7 @@ func (bc *Client) GetOrder(orderID int64) (*Order, error) {
 	url := "/v2/orders/" + strconv.FormatInt(orderID, 10)
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -292,26 +302,8 @@ func (bc *Client) GetOrder(orderID int64) (*Order, error) {
 
 // GetOrderProducts returns all products for a given order
 func (bc *Client) GetOrderProducts(orderID int64) ([]OrderProduct, error) {
-	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/products"
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer res.Body.Close()
-	body, err := processBody(res)
-	if err != nil {
-		return nil, err
-	}
-
-	var products []OrderProduct
-	err = json.Unmarshal(body, &products)
-	if err != nil {
-		return nil, err
-	}
-	return products, nil
+	path := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/products"
+	return newV2Iterator[OrderProduct](bc, path, url.Values{}).Limit(250).All()
 }
 
 // GetOrderShippingAddresses returns all shipping addresses for a given order
@@ -319,7 +311,7 @@ func (bc *Client) GetOrderShippingAddresses(orderID int64) ([]OrderShippingAddre
 	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/shipping_addresses"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +338,7 @@ func (bc *Client) GetOrderCoupons(orderID int64) ([]OrderCoupon, error) {
 	url := "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/coupons"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -364,3 +356,159 @@ func (bc *Client) GetOrderCoupons(orderID int64) ([]OrderCoupon, error) {
 	}
 	return coupons, nil
 }
+
+// OrderStatus is the ID of an order status, as in Order.StatusID and the webhook status IDs
+type OrderStatus int
+
+// order status IDs
+const (
+	OrderStatusIncomplete                 OrderStatus = 0
+	OrderStatusPending                    OrderStatus = 1
+	OrderStatusShipped                    OrderStatus = 2
+	OrderStatusPartiallyShipped           OrderStatus = 3
+	OrderStatusRefunded                   OrderStatus = 4
+	OrderStatusCancelled                  OrderStatus = 5
+	OrderStatusDeclined                   OrderStatus = 6
+	OrderStatusAwaitingPayment            OrderStatus = 7
+	OrderStatusAwaitingPickup             OrderStatus = 8
+	OrderStatusAwaitingShipment           OrderStatus = 9
+	OrderStatusCompleted                  OrderStatus = 10
+	OrderStatusAwaitingFulfillment        OrderStatus = 11
+	OrderStatusManualVerificationRequired OrderStatus = 12
+	OrderStatusDisputed                   OrderStatus = 13
+	OrderStatusPartiallyRefunded          OrderStatus = 14
+)
+
+var orderStatusNames = map[OrderStatus]string{
+	OrderStatusIncomplete:                 "Incomplete",
+	OrderStatusPending:                    "Pending",
+	OrderStatusShipped:                    "Shipped",
+	OrderStatusPartiallyShipped:           "Partially Shipped",
+	OrderStatusRefunded:                   "Refunded",
+	OrderStatusCancelled:                  "Cancelled",
+	OrderStatusDeclined:                   "Declined",
+	OrderStatusAwaitingPayment:            "Awaiting Payment",
+	OrderStatusAwaitingPickup:             "Awaiting Pickup",
+	OrderStatusAwaitingShipment:           "Awaiting Shipment",
+	OrderStatusCompleted:                  "Completed",
+	OrderStatusAwaitingFulfillment:        "Awaiting Fulfillment",
+	OrderStatusManualVerificationRequired: "Manual Verification Required",
+	OrderStatusDisputed:                   "Disputed",
+	OrderStatusPartiallyRefunded:          "Partially Refunded",
+}
+
+// String returns the system label of the status, e.g. Awaiting Fulfillment
+func (s OrderStatus) String() string {
+	if name, ok := orderStatusNames[s]; ok {
+		return name
+	}
+	return "OrderStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+// OrderStatusInfo is an order status with the store's custom label
+type OrderStatusInfo struct {
+	ID                OrderStatus `json:"id"`
+	Name              string      `json:"name"`
+	SystemLabel       string      `json:"system_label"`
+	CustomLabel       string      `json:"custom_label"`
+	SystemDescription string      `json:"system_description"`
+	Order             int         `json:"order"`
+}
+
+// OrderPayload is the body of CreateOrder and UpdateOrder, only the set fields are sent.
+// CreateOrder requires BillingAddress and Products, CustomerID 0 creates a guest order
+type OrderPayload struct {
+	CustomerID          int64                 `json:"customer_id,omitempty"`
+	StatusID            *OrderStatus          `json:"status_id,omitempty"`
+	BillingAddress      *OrderAddress         `json:"billing_address,omitempty"`
+	ShippingAddresses   []OrderAddress        `json:"shipping_addresses,omitempty"`
+	Products            []OrderProductPayload `json:"products,omitempty"`
+	BaseShippingCost    *Decimal              `json:"base_shipping_cost,omitempty"`
+	ShippingCostExTax   *Decimal              `json:"shipping_cost_ex_tax,omitempty"`
+	ShippingCostIncTax  *Decimal              `json:"shipping_cost_inc_tax,omitempty"`
+	BaseHandlingCost    *Decimal              `json:"base_handling_cost,omitempty"`
+	HandlingCostExTax   *Decimal              `json:"handling_cost_ex_tax,omitempty"`
+	HandlingCostIncTax  *Decimal              `json:"handling_cost_inc_tax,omitempty"`
+	SubtotalExTax       *Decimal              `json:"subtotal_ex_tax,omitempty"`
+	SubtotalIncTax      *Decimal              `json:"subtotal_inc_tax,omitempty"`
+	TotalExTax          *Decimal              `json:"total_ex_tax,omitempty"`
+	TotalIncTax         *Decimal              `json:"total_inc_tax,omitempty"`
+	DiscountAmount      *Decimal              `json:"discount_amount,omitempty"`
+	PaymentMethod       *string               `json:"payment_method,omitempty"`
+	PaymentProviderID   *string               `json:"payment_provider_id,omitempty"`
+	CustomerMessage     *string               `json:"customer_message,omitempty"`
+	StaffNotes          *string               `json:"staff_notes,omitempty"`
+	ExternalSource      *string               `json:"external_source,omitempty"` // e.g. POS
+	ExternalID          *string               `json:"external_id,omitempty"`
+	ChannelID           *int64                `json:"channel_id,omitempty"`
+	DefaultCurrencyCode *string               `json:"default_currency_code,omitempty"`
+	CustomerLocale      *string               `json:"customer_locale,omitempty"`
+	IPAddress           *string               `json:"ip_address,omitempty"`
+	DateCreated         *string               `json:"date_created,omitempty"` // RFC 1123
+}
+
+// OrderProductPayload is a product line of OrderPayload. Catalog products need ProductID,
+// Quantity and their ProductOptions; custom products need Name, Quantity and the prices
+type OrderProductPayload struct {
+	ID             int64                       `json:"id,omitempty"` // order product ID, to change a line with UpdateOrder
+	ProductID      int64                       `json:"product_id,omitempty"`
+	Quantity       int                         `json:"quantity"`
+	ProductOptions []OrderProductOptionPayload `json:"product_options,omitempty"`
+	Name           string                      `json:"name,omitempty"`
+	NameCustomer   string                      `json:"name_customer,omitempty"`
+	NameMerchant   string                      `json:"name_merchant,omitempty"`
+	Sku            string                      `json:"sku,omitempty"`
+	Upc            string                      `json:"upc,omitempty"`
+	PriceExTax     *Decimal                    `json:"price_ex_tax,omitempty"`
+	PriceIncTax    *Decimal                    `json:"price_inc_tax,omitempty"`
+}
+
+// OrderProductOptionPayload selects an option value for an order product,
+// ID is the product option ID and Value the option value ID or text
+type OrderProductOptionPayload struct {
+	ID    int64  `json:"id"`
+	Value string `json:"value"`
+}
+
+// CreateOrder creates an order, e.g. from a point of sale or a marketplace
+func (bc *Client) CreateOrder(payload *OrderPayload) (*Order, error) {
+	if payload.BillingAddress == nil || len(payload.Products) == 0 {
+		return nil, errors.New("order billing address and products are required")
+	}
+	var order Order
+	err := bc.sendJSON(http.MethodPost, "/v2/orders", payload, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// UpdateOrder updates the given fields of an order and returns the updated order
+func (bc *Client) UpdateOrder(orderID int64, patch *OrderPayload) (*Order, error) {
+	var order Order
+	err := bc.sendJSON(http.MethodPut, "/v2/orders/"+strconv.FormatInt(orderID, 10), patch, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// UpdateOrderStatus moves an order to the given status
+func (bc *Client) UpdateOrderStatus(orderID int64, status OrderStatus) (*Order, error) {
+	return bc.UpdateOrder(orderID, &OrderPayload{StatusID: &status})
+}
+
+// ArchiveOrder archives an order, it's kept with is_deleted set and can be restored in the control panel
+func (bc *Client) ArchiveOrder(orderID int64) error {
+	return bc.sendJSON(http.MethodDelete, "/v2/orders/"+strconv.FormatInt(orderID, 10), nil, nil)
+}
+
+// GetOrderStatuses returns the order statuses with the store's custom labels
+func (bc *Client) GetOrderStatuses() ([]OrderStatusInfo, error) {
+	var statuses []OrderStatusInfo
+	err := bc.sendJSON(http.MethodGet, "/v2/order_statuses", nil, &statuses)
+	if err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"time"
 )
@@ -31,7 +30,7 @@ func (bc *Client) CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTem
 		return nil, err
 	}
 	req := bc.getAPIRequest(http.MethodPost, "/v3/content/widget-templates", bytes.NewReader(ptJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return pt, err
 	}
@@ -39,7 +38,7 @@ func (bc *Client) CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTem
 	var ptRes struct {
 		Data PageBuilderTemplate `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return pt, err
 	}
@@ -52,7 +51,7 @@ func (bc *Client) CreateWidgetTemplate(pt *PageBuilderTemplate) (*PageBuilderTem
 
 func (bc *Client) GetWidgetTemplates() ([]PageBuilderTemplate, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/content/widget-templates", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +59,7 @@ func (bc *Client) GetWidgetTemplates() ([]PageBuilderTemplate, error) {
 	var ptRes struct {
 		Data []PageBuilderTemplate `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return nil, err
 	}
@@ -73,13 +72,14 @@ func (bc *Client) GetWidgetTemplates() ([]PageBuilderTemplate, error) {
 
 func (bc *Client) DeleteWidgetTemplate(uuid string) error {
 	req := bc.getAPIRequest(http.MethodDelete, fmt.Sprintf("/v3/content/widget-templates/%s", uuid), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
-	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("error deleting widget template: %d", res.StatusCode)
+	_, err = processBody(res)
+	if err != nil && err != ErrNoContent {
+		return err
 	}
 	return nil
 }
@@ -0,0 +1,86 @@
+package bigcommerce
+
+import (
+	"context"
+	"sync"
+)
+
+// GetAllParallel downloads all items of a v3 list endpoint using up to workers concurrent requests.
+// Page 1 is fetched first to learn total_pages, the remaining pages are fetched by the workers
+// and returned in page order. Workers share the client's rate limit budget: they pause when
+// fewer than RateLimitReserve+workers requests are left in the window.
+// On the first error (after the client's retries) all workers are stopped and
+// the items of the pages before the failed one are returned with the error.
+// path: the endpoint, e.g. /v3/catalog/products
+// args: additional query arguments to pass to the API, may be nil
+func GetAllParallel[T any](bc *Client, path string, args map[string]string, workers int) ([]T, error) {
+	query := argsQuery(args)
+	first, pagination, err := getPage[T](bc, path, query, 1)
+	if err == ErrNoContent {
+		return []T{}, nil
+	}
+	if err != nil {
+		return []T{}, err
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	total := pagination.TotalPages
+	if total < 1 {
+		total = 1
+	}
+	pages := make([][]T, total)
+	fetched := make([]bool, total)
+	pages[0], fetched[0] = first, true
+
+	ctx, cancel := context.WithCancel(bc.Context())
+	defer cancel()
+	wc := bc.WithContext(ctx)
+	wc.RateLimitReserve += workers
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		jobs     = make(chan int)
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range jobs {
+				items, _, err := getPage[T](wc, path, query, page)
+				if err != nil && err != ErrNoContent {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				pages[page-1], fetched[page-1] = items, true
+			}
+		}()
+	}
+feed:
+	for page := 2; page <= total; page++ {
+		select {
+		case jobs <- page:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = bc.Context().Err()
+	}
+	ret := []T{}
+	for i := range pages {
+		if !fetched[i] {
+			break
+		}
+		ret = append(ret, pages[i]...)
+	}
+	return ret, firstErr
+}
@@ -0,0 +1,200 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// item types of a RefundItem
+const (
+	RefundItemProduct      = "PRODUCT"       // ItemID is the order product ID, refund Quantity
+	RefundItemGiftWrapping = "GIFT_WRAPPING" // ItemID is the order product ID
+	RefundItemShipping     = "SHIPPING"      // ItemID is the order address ID
+	RefundItemHandling     = "HANDLING"      // ItemID is the order address ID
+	RefundItemOrder        = "ORDER"         // ItemID is the order ID, refund an Amount of the order
+)
+
+// Transaction is a payment event of an order, e.g. an authorization, capture or refund
+type Transaction struct {
+	ID                     int64     `json:"id"`
+	OrderID                string    `json:"order_id"`
+	Event                  string    `json:"event"`  // purchase, authorization, capture, refund, void, pending or settled
+	Method                 string    `json:"method"` // e.g. credit_card, gift_certificate, store_credit, custom
+	Amount                 Decimal   `json:"amount"`
+	Currency               string    `json:"currency"`
+	Gateway                string    `json:"gateway"`
+	GatewayTransactionID   string    `json:"gateway_transaction_id"`
+	PaymentMethodID        string    `json:"payment_method_id"`
+	DateCreated            time.Time `json:"date_created"`
+	Test                   bool      `json:"test"`
+	Status                 string    `json:"status"` // ok or error
+	FraudReview            bool      `json:"fraud_review"`
+	ReferenceTransactionID int64     `json:"reference_transaction_id"`
+	Offline                *struct {
+		DisplayName string `json:"display_name"`
+	} `json:"offline,omitempty"`
+	Custom *struct {
+		PaymentMethod string `json:"payment_method"`
+	} `json:"custom,omitempty"`
+	CreditCard *struct {
+		CardType        string `json:"card_type"`
+		CardIin         string `json:"card_iin"`
+		CardLast4       string `json:"card_last4"`
+		CardExpiryMonth int    `json:"card_expiry_month"`
+		CardExpiryYear  int    `json:"card_expiry_year"`
+	} `json:"credit_card,omitempty"`
+}
+
+// RefundItem is a part of an order to refund, see the RefundItem* constants for ItemType
+type RefundItem struct {
+	ItemType        string   `json:"item_type"`
+	ItemID          int64    `json:"item_id"`
+	Quantity        int      `json:"quantity,omitempty"`
+	Amount          *Decimal `json:"amount,omitempty"`
+	Reason          string   `json:"reason,omitempty"`
+	RequestedAmount *Decimal `json:"requested_amount,omitempty"` // set in the items of a Refund
+}
+
+// RefundQuote is the amount BigCommerce calculated for refunding items, with the payment
+// methods it can be refunded to
+type RefundQuote struct {
+	OrderID              int64   `json:"order_id"`
+	TotalRefundAmount    Decimal `json:"total_refund_amount"`
+	TotalRefundTaxAmount Decimal `json:"total_refund_tax_amount"`
+	Rounding             Decimal `json:"rounding"`
+	Adjustment           Decimal `json:"adjustment"`
+	TaxInclusive         bool    `json:"tax_inclusive"`
+	// RefundMethods are the ways the amount can be refunded, each a set of payments
+	RefundMethods [][]RefundMethod `json:"refund_methods"`
+}
+
+// RefundMethod is a payment provider a quoted amount can be refunded to
+type RefundMethod struct {
+	ProviderID          string  `json:"provider_id"`
+	ProviderDescription string  `json:"provider_description"`
+	Amount              Decimal `json:"amount"`
+	Offline             bool    `json:"offline"`
+	OfflineProvider     bool    `json:"offline_provider"`
+	OfflineReason       string  `json:"offline_reason"`
+}
+
+// RefundPayment is the amount of a refund sent to a payment provider
+type RefundPayment struct {
+	ID              int64   `json:"id,omitempty"`
+	ProviderID      string  `json:"provider_id"`
+	Amount          Decimal `json:"amount"`
+	Offline         bool    `json:"offline"`
+	IsDeclined      bool    `json:"is_declined,omitempty"`
+	DeclinedMessage string  `json:"declined_message,omitempty"`
+}
+
+// RefundRequest is the body of CreateRefund. Payments usually come from one of the
+// RefundMethods of a RefundQuote for the same items
+type RefundRequest struct {
+	Items    []RefundItem    `json:"items"`
+	Payments []RefundPayment `json:"payments"`
+	// MerchantCalculatedOverride replaces the amount and tax BigCommerce calculates
+	MerchantCalculatedOverride *RefundOverride `json:"merchant_calculated_override,omitempty"`
+}
+
+// RefundOverride is a refund total and tax calculated by the merchant
+type RefundOverride struct {
+	TotalAmount Decimal `json:"total_amount"`
+	TotalTax    Decimal `json:"total_tax"`
+}
+
+// Refund is a refund of an order
+type Refund struct {
+	ID                         int64           `json:"id"`
+	OrderID                    int64           `json:"order_id"`
+	UserID                     int64           `json:"user_id"`
+	Created                    time.Time       `json:"created"`
+	Reason                     string          `json:"reason"`
+	TotalAmount                Decimal         `json:"total_amount"`
+	TotalTax                   Decimal         `json:"total_tax"`
+	UsesMerchantOverrideValues bool            `json:"uses_merchant_override_values"`
+	Payments                   []RefundPayment `json:"payments"`
+	Items                      []RefundItem    `json:"items"`
+}
+
+// RefundPayments returns the payments of the i-th refund method, for a RefundRequest
+func (q *RefundQuote) RefundPayments(i int) []RefundPayment {
+	if i < 0 || i >= len(q.RefundMethods) {
+		return nil
+	}
+	ps := make([]RefundPayment, len(q.RefundMethods[i]))
+	for j, m := range q.RefundMethods[i] {
+		ps[j] = RefundPayment{ProviderID: m.ProviderID, Amount: m.Amount, Offline: m.Offline}
+	}
+	return ps
+}
+
+func paymentActionsPath(orderID int64) string {
+	return "/v3/orders/" + strconv.FormatInt(orderID, 10) + "/payment_actions"
+}
+
+// GetOrderTransactions returns the payment transactions of an order
+func (bc *Client) GetOrderTransactions(orderID int64) ([]Transaction, error) {
+	return NewIterator[Transaction](bc, "/v3/orders/"+strconv.FormatInt(orderID, 10)+"/transactions", nil).All()
+}
+
+// GetRefundQuote returns the refundable amount, tax and refund methods for items of an order
+func (bc *Client) GetRefundQuote(orderID int64, items []RefundItem) (*RefundQuote, error) {
+	if len(items) == 0 {
+		return nil, errors.New("refund items are required")
+	}
+	payload := struct {
+		Items []RefundItem `json:"items"`
+	}{items}
+	var ret RefundQuote
+	err := bc.sendData(http.MethodPost, paymentActionsPath(orderID)+"/refund_quotes", payload, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateRefund refunds items of an order to the given payments.
+// It is not retried on server errors, check GetOrderRefunds before trying again
+func (bc *Client) CreateRefund(orderID int64, req *RefundRequest) (*Refund, error) {
+	if len(req.Items) == 0 || len(req.Payments) == 0 {
+		return nil, errors.New("refund items and payments are required")
+	}
+	var ret Refund
+	err := bc.sendData(http.MethodPost, paymentActionsPath(orderID)+"/refunds", req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// GetOrderRefunds returns the refunds of an order
+func (bc *Client) GetOrderRefunds(orderID int64) ([]Refund, error) {
+	return NewIterator[Refund](bc, paymentActionsPath(orderID)+"/refunds", nil).All()
+}
+
+// GetAllRefunds returns the refunds of all orders
+// args is a map of arguments to pass to the API, e.g. order_id:in, created:min or created:max
+func (bc *Client) GetAllRefunds(args map[string]string) ([]Refund, error) {
+	return bc.IterateRefunds(args).All()
+}
+
+// IterateRefunds returns an iterator over the refunds of all orders, fetching them page by page
+// args is a map of arguments to pass to the API, e.g. order_id:in, created:min or created:max
+func (bc *Client) IterateRefunds(args map[string]string) *Iterator[Refund] {
+	return NewIterator[Refund](bc, "/v3/orders/payment_actions/refunds", args)
+}
+
+// CaptureOrderPayment captures the authorized payment of an order, the capture is asynchronous:
+// its result shows up in GetOrderTransactions
+func (bc *Client) CaptureOrderPayment(orderID int64) error {
+	return bc.sendJSON(http.MethodPost, paymentActionsPath(orderID)+"/capture", nil, nil)
+}
+
+// VoidOrderPayment voids the authorized payment of an order, the void is asynchronous:
+// its result shows up in GetOrderTransactions
+func (bc *Client) VoidOrderPayment(orderID int64) error {
+	return bc.sendJSON(http.MethodPost, paymentActionsPath(orderID)+"/void", nil, nil)
+}
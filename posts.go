@@ -3,12 +3,9 @@ package bigcommerce
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
-	"fmt"
-	"log"
 	"net/http"
+	"net/url"
 	"strconv"
-	"strings"
 )
 
 // Post is a BC blog post
@@ -44,26 +41,7 @@ type CreatePostPayload struct {
 
 // GetAllPosts downloads all posts from BigCommerce, handling pagination
 func (bc *Client) GetAllPosts() ([]Post, error) {
-	cs := []Post{}
-	var csp []Post
-	page := 1
-	more := true
-	var err error
-	retries := 0
-	for more {
-		csp, more, err = bc.GetPosts(page)
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				log.Println("Max retries reached")
-				return cs, fmt.Errorf("max retries reached")
-			}
-			break
-		}
-		cs = append(cs, csp...)
-		page++
-	}
-	return cs, err
+	return newV2Iterator[Post](bc, "/v2/blog/posts", url.Values{}).Limit(250).All()
 }
 
 // GetPosts downloads all posts from BigCommerce, handling pagination
@@ -72,7 +50,7 @@ func (bc *Client) GetPosts(page int) ([]Post, bool, error) {
 	url := "/v2/blog/posts?limit=250&page=" + strconv.Itoa(page)
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, false, err
 	}
@@ -86,7 +64,6 @@ func (bc *Client) GetPosts(page int) ([]Post, bool, error) {
 	var pp []Post
 	err = json.Unmarshal(body, &pp)
 	if err != nil {
-		log.Printf("Error unmarshalling posts: %s %s", err, string(body))
 		return nil, false, err
 	}
 	return pp, len(pp) == 250, nil
@@ -97,30 +74,13 @@ func (bc *Client) CreatePost(payload *CreatePostPayload) (*Post, error) {
 	var b []byte
 	b, _ = json.Marshal([]CreatePostPayload{*payload})
 	req := bc.getAPIRequest(http.MethodPost, "/v2/blog/posts", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return nil, err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return nil, fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return nil, errors.New("unknown error")
-		}
-		log.Printf("Error: %s\nResult: %s", err, string(body))
 		return nil, err
 	}
 
@@ -0,0 +1,35 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetAllPostsEndsOnNoContent(t *testing.T) {
+	var pages []string
+	bc := NewClient("store", "token")
+	bc.HTTPClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		page := req.URL.Query().Get("page")
+		pages = append(pages, page)
+		if page != "1" {
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		}
+		posts := make([]Post, 250)
+		for i := range posts {
+			posts[i].ID = int64(i + 1)
+		}
+		b, _ := json.Marshal(posts)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(string(b))), Header: http.Header{}}, nil
+	})}
+
+	posts, err := bc.GetAllPosts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 250 || strings.Join(pages, ",") != "1,2" {
+		t.Errorf("got %d posts from pages %v, want 250 from pages [1 2]", len(posts), pages)
+	}
+}
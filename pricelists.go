@@ -0,0 +1,198 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// priceRecordBatchSize is the maximum number of price records per upsert request
+const priceRecordBatchSize = 1000
+
+// PriceList is a set of prices that overrides the catalog prices for the
+// customer groups and channels it is assigned to
+type PriceList struct {
+	ID           int64     `json:"id,omitempty"`
+	Name         string    `json:"name"`
+	Active       bool      `json:"active"`
+	DateCreated  time.Time `json:"date_created,omitempty"`
+	DateModified time.Time `json:"date_modified,omitempty"`
+}
+
+// PriceRecord is the price of a variant in a price list, for one currency
+type PriceRecord struct {
+	PriceListID      int64             `json:"price_list_id"`
+	VariantID        int64             `json:"variant_id"`
+	ProductID        int64             `json:"product_id"`
+	SKU              string            `json:"sku"`
+	Currency         string            `json:"currency"`
+	Price            Decimal           `json:"price"`
+	SalePrice        Decimal           `json:"sale_price"`
+	RetailPrice      Decimal           `json:"retail_price"`
+	MapPrice         Decimal           `json:"map_price"`
+	CalculatedPrice  Decimal           `json:"calculated_price"`
+	DateCreated      time.Time         `json:"date_created"`
+	DateModified     time.Time         `json:"date_modified"`
+	BulkPricingTiers []BulkPricingTier `json:"bulk_pricing_tiers"`
+}
+
+// BulkPricingTier is a quantity discount of a price record, Type is one of the BulkPricing* constants
+type BulkPricingTier struct {
+	QuantityMin int     `json:"quantity_min"`
+	QuantityMax int     `json:"quantity_max"`
+	Type        string  `json:"type"`
+	Amount      Decimal `json:"amount"`
+}
+
+// PriceRecordPayload is an item of UpsertPriceRecords, only the non-nil prices are sent
+// one of VariantID and SKU is required, Currency is the ISO code, e.g. USD
+type PriceRecordPayload struct {
+	VariantID        int64             `json:"variant_id,omitempty"`
+	SKU              string            `json:"sku,omitempty"`
+	Currency         string            `json:"currency"`
+	Price            *Decimal          `json:"price,omitempty"`
+	SalePrice        *Decimal          `json:"sale_price,omitempty"`
+	RetailPrice      *Decimal          `json:"retail_price,omitempty"`
+	MapPrice         *Decimal          `json:"map_price,omitempty"`
+	BulkPricingTiers []BulkPricingTier `json:"bulk_pricing_tiers,omitempty"`
+}
+
+// PriceListAssignment assigns a price list to a customer group, a channel, or both
+type PriceListAssignment struct {
+	ID              int64 `json:"id,omitempty"`
+	PriceListID     int64 `json:"price_list_id"`
+	CustomerGroupID int64 `json:"customer_group_id,omitempty"`
+	ChannelID       int64 `json:"channel_id,omitempty"`
+}
+
+func priceListPath(priceListID int64) string {
+	return "/v3/pricelists/" + strconv.FormatInt(priceListID, 10)
+}
+
+// GetPriceLists returns all price lists
+// args is a map of arguments to pass to the API, e.g. name or id:in
+func (bc *Client) GetPriceLists(args map[string]string) ([]PriceList, error) {
+	return NewIterator[PriceList](bc, "/v3/pricelists", args).All()
+}
+
+// GetPriceList returns a price list by ID
+func (bc *Client) GetPriceList(priceListID int64) (*PriceList, error) {
+	return bc.sendPriceList(http.MethodGet, priceListPath(priceListID), nil)
+}
+
+// CreatePriceList creates a price list
+func (bc *Client) CreatePriceList(name string, active bool) (*PriceList, error) {
+	return bc.sendPriceList(http.MethodPost, "/v3/pricelists", priceListPayload{name, active})
+}
+
+// UpdatePriceList renames, activates or deactivates a price list
+func (bc *Client) UpdatePriceList(priceListID int64, name string, active bool) (*PriceList, error) {
+	return bc.sendPriceList(http.MethodPut, priceListPath(priceListID), priceListPayload{name, active})
+}
+
+// DeletePriceList deletes a price list with its records and assignments
+func (bc *Client) DeletePriceList(priceListID int64) error {
+	return bc.sendJSON(http.MethodDelete, priceListPath(priceListID), nil, nil)
+}
+
+type priceListPayload struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+func (bc *Client) sendPriceList(method, path string, payload interface{}) (*PriceList, error) {
+	var ret PriceList
+	err := bc.sendData(method, path, payload, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// GetAllPriceRecords returns all records of a price list
+// args is a map of arguments to pass to the API, e.g. currency, variant_id:in or sku:in
+func (bc *Client) GetAllPriceRecords(priceListID int64, args map[string]string) ([]PriceRecord, error) {
+	return bc.IteratePriceRecords(priceListID, args).All()
+}
+
+// IteratePriceRecords returns an iterator over the records of a price list, fetching them page by page
+// args is a map of arguments to pass to the API, e.g. currency, variant_id:in or sku:in
+func (bc *Client) IteratePriceRecords(priceListID int64, args map[string]string) *Iterator[PriceRecord] {
+	return NewIterator[PriceRecord](bc, priceListPath(priceListID)+"/records", args)
+}
+
+// GetPriceRecords returns a page of records of a price list
+// args is a map of arguments to pass to the API
+// page: the page number to download
+func (bc *Client) GetPriceRecords(priceListID int64, args map[string]string, page int) ([]PriceRecord, bool, error) {
+	rs, p, err := getPage[PriceRecord](bc, priceListPath(priceListID)+"/records", argsQuery(args), page)
+	if err != nil {
+		return nil, false, err
+	}
+	return rs, p.CurrentPage < p.TotalPages, nil
+}
+
+// UpsertPriceRecords creates or replaces records of a price list in batches of 1000.
+// When a batch is rejected, its records are resent one by one and the failing ones are
+// reported in a *BatchError with their index in records as ID
+func (bc *Client) UpsertPriceRecords(priceListID int64, records []PriceRecordPayload) error {
+	for _, r := range records {
+		if (r.VariantID == 0) == (r.SKU == "") || r.Currency == "" {
+			return errors.New("price record needs a currency and one of variant ID and SKU")
+		}
+	}
+	path := priceListPath(priceListID) + "/records"
+	_, err := batchUpdateByIndex(records, priceRecordBatchSize,
+		func(batch []PriceRecordPayload) ([]PriceRecordPayload, error) {
+			return batch, bc.sendJSON(http.MethodPut, path, batch, nil)
+		})
+	return err
+}
+
+// DeletePriceRecords deletes the records of the given variants from a price list,
+// in all currencies if currency is empty
+func (bc *Client) DeletePriceRecords(priceListID int64, variantIDs []int64, currency string) error {
+	for start := 0; start < len(variantIDs); start += deleteBatchSize {
+		end := start + deleteBatchSize
+		if end > len(variantIDs) {
+			end = len(variantIDs)
+		}
+		q := url.Values{}
+		q.Set("variant_id:in", joinInts(variantIDs[start:end]))
+		if currency != "" {
+			q.Set("currency", currency)
+		}
+		err := bc.sendJSON(http.MethodDelete, priceListPath(priceListID)+"/records?"+encodeQuery(q), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPriceListAssignments returns price list assignments
+// args is a map of arguments to pass to the API, e.g. price_list_id, customer_group_id or channel_id
+func (bc *Client) GetPriceListAssignments(args map[string]string) ([]PriceListAssignment, error) {
+	return NewIterator[PriceListAssignment](bc, "/v3/pricelists/assignments", args).All()
+}
+
+// CreatePriceListAssignments assigns price lists to customer groups and channels
+func (bc *Client) CreatePriceListAssignments(assignments []PriceListAssignment) error {
+	for _, a := range assignments {
+		if a.PriceListID == 0 || a.CustomerGroupID == 0 && a.ChannelID == 0 {
+			return errors.New("price list assignment needs a price list ID and a customer group or channel ID")
+		}
+	}
+	return bc.sendJSON(http.MethodPost, "/v3/pricelists/assignments", assignments, nil)
+}
+
+// DeletePriceListAssignments deletes the assignments matching args, at least one filter is required
+// args is a map of arguments to pass to the API, e.g. price_list_id, customer_group_id or channel_id
+func (bc *Client) DeletePriceListAssignments(args map[string]string) error {
+	if len(args) == 0 {
+		return errors.New("a filter is required to delete price list assignments")
+	}
+	return bc.sendJSON(http.MethodDelete, "/v3/pricelists/assignments?"+encodeQuery(argsQuery(args)), nil, nil)
+}
@@ -4,23 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 )
 
-// include_fields
-var productFields = []string{"name", "sku", "custom_url", "is_visible", "price"}
-
-// include (subresources, like variants images custom_fields bulk_pricing_rules primary_image modifiers options videos)
-var productInclude []string
-
-// extra arguments for product interface
-var productArgs map[string]string
+// subresources GetProductByID includes when SetProductInclude was not called
+var productIncludeAll = []string{"variants", "images", "custom_fields", "bulk_pricing_rules", "primary_image", "modifiers", "options", "videos"}
 
 // Product is a BigCommerce product object
 type Product struct {
@@ -33,14 +25,14 @@ type Product struct {
 	Width                   float64       `json:"width,omitempty"`
 	Depth                   float64       `json:"depth,omitempty"`
 	Height                  float64       `json:"height,omitempty"`
-	Price                   float64       `json:"price,omitempty"`
-	CostPrice               float64       `json:"cost_price,omitempty"`
-	RetailPrice             float64       `json:"retail_price,omitempty"`
-	SalePrice               float64       `json:"sale_price,omitempty"`
-	MapPrice                float64       `json:"map_price,omitempty"`
+	Price                   Decimal       `json:"price"`
+	CostPrice               Decimal       `json:"cost_price"`
+	RetailPrice             Decimal       `json:"retail_price"`
+	SalePrice               Decimal       `json:"sale_price"`
+	MapPrice                Decimal       `json:"map_price"`
 	TaxClassID              int64         `json:"tax_class_id,omitempty"`
 	ProductTaxCode          string        `json:"product_tax_code,omitempty"`
-	CalculatedPrice         float64       `json:"calculated_price,omitempty"`
+	CalculatedPrice         Decimal       `json:"calculated_price"`
 	Categories              []interface{} `json:"categories,omitempty"`
 	BrandID                 int64         `json:"brand_id,omitempty"`
 	OptionSetID             interface{}   `json:"option_set_id,omitempty"`
@@ -51,7 +43,7 @@ type Product struct {
 	ReviewsRatingSum        int           `json:"reviews_rating_sum,omitempty"`
 	ReviewsCount            int           `json:"reviews_count,omitempty"`
 	TotalSold               int           `json:"total_sold,omitempty"`
-	FixedCostShippingPrice  float64       `json:"fixed_cost_shipping_price,omitempty"`
+	FixedCostShippingPrice  Decimal       `json:"fixed_cost_shipping_price"`
 	IsFreeShipping          bool          `json:"is_free_shipping,omitempty"`
 	IsVisible               bool          `json:"is_visible,omitempty"`
 	IsFeatured              bool          `json:"is_featured,omitempty"`
@@ -87,49 +79,21 @@ type Product struct {
 		URL          string `json:"url,omitempty"`
 		IsCustomized bool   `json:"is_customized,omitempty"`
 	} `json:"custom_url,omitempty"`
-	BaseVariantID               int64  `json:"base_variant_id,omitempty"`
-	OpenGraphType               string `json:"open_graph_type,omitempty"`
-	OpenGraphTitle              string `json:"open_graph_title,omitempty"`
-	OpenGraphDescription        string `json:"open_graph_description,omitempty"`
-	OpenGraphUseMetaDescription bool   `json:"open_graph_use_meta_description,omitempty"`
-	OpenGraphUseProductName     bool   `json:"open_graph_use_product_name,omitempty"`
-	OpenGraphUseImage           bool   `json:"open_graph_use_image,omitempty"`
-	Variants                    []struct {
-		ID                        int64         `json:"id,omitempty"`
-		ProductID                 int64         `json:"product_id,omitempty"`
-		Sku                       string        `json:"sku,omitempty"`
-		SkuID                     interface{}   `json:"sku_id,omitempty"`
-		Price                     float64       `json:"price,omitempty"`
-		CalculatedPrice           float64       `json:"calculated_price,omitempty"`
-		SalePrice                 float64       `json:"sale_price,omitempty"`
-		RetailPrice               float64       `json:"retail_price,omitempty"`
-		MapPrice                  float64       `json:"map_price,omitempty"`
-		Weight                    float64       `json:"weight,omitempty"`
-		Width                     int           `json:"width,omitempty"`
-		Height                    int           `json:"height,omitempty"`
-		Depth                     int           `json:"depth,omitempty"`
-		IsFreeShipping            bool          `json:"is_free_shipping,omitempty"`
-		FixedCostShippingPrice    float64       `json:"fixed_cost_shipping_price,omitempty"`
-		CalculatedWeight          float64       `json:"calculated_weight,omitempty"`
-		PurchasingDisabled        bool          `json:"purchasing_disabled,omitempty"`
-		PurchasingDisabledMessage string        `json:"purchasing_disabled_message,omitempty"`
-		ImageURL                  string        `json:"image_url,omitempty"`
-		CostPrice                 float64       `json:"cost_price,omitempty"`
-		Upc                       string        `json:"upc,omitempty"`
-		Mpn                       string        `json:"mpn,omitempty"`
-		Gtin                      string        `json:"gtin,omitempty"`
-		InventoryLevel            int           `json:"inventory_level,omitempty"`
-		InventoryWarningLevel     int           `json:"inventory_warning_level,omitempty"`
-		BinPickingNumber          string        `json:"bin_picking_number,omitempty"`
-		OptionValues              []interface{} `json:"option_values,omitempty"`
-	} `json:"variants,omitempty"`
-	Images           []Image           `json:"images,omitempty"`
-	PrimaryImage     interface{}       `json:"primary_image,omitempty"`
-	Videos           []Video           `json:"videos,omitempty"`
-	CustomFields     []CustomField     `json:"custom_fields,omitempty"`
-	BulkPricingRules []BulkPricingRule `json:"bulk_pricing_rules,omitempty"`
-	Options          []interface{}     `json:"options,omitempty"`
-	Modifiers        []interface{}     `json:"modifiers,omitempty"`
+	BaseVariantID               int64             `json:"base_variant_id,omitempty"`
+	OpenGraphType               string            `json:"open_graph_type,omitempty"`
+	OpenGraphTitle              string            `json:"open_graph_title,omitempty"`
+	OpenGraphDescription        string            `json:"open_graph_description,omitempty"`
+	OpenGraphUseMetaDescription bool              `json:"open_graph_use_meta_description,omitempty"`
+	OpenGraphUseProductName     bool              `json:"open_graph_use_product_name,omitempty"`
+	OpenGraphUseImage           bool              `json:"open_graph_use_image,omitempty"`
+	Variants                    []Variant         `json:"variants,omitempty"`
+	Images                      []Image           `json:"images,omitempty"`
+	PrimaryImage                interface{}       `json:"primary_image,omitempty"`
+	Videos                      []Video           `json:"videos,omitempty"`
+	CustomFields                []CustomField     `json:"custom_fields,omitempty"`
+	BulkPricingRules            []BulkPricingRule `json:"bulk_pricing_rules,omitempty"`
+	Options                     []VariantOption   `json:"options,omitempty"`
+	Modifiers                   []Modifier        `json:"modifiers,omitempty"`
 }
 
 type CustomField struct {
@@ -139,25 +103,11 @@ type CustomField struct {
 }
 
 type BulkPricingRule struct {
-	ID          int64  `json:"id" validate:"required"`
-	QuantityMin int    `json:"quantity_min" validate:"required"`
-	QuantityMax int    `json:"quantity_max" validate:"required"`
-	Type        string `json:"type" validate:"required"`
-	Amount      string `json:"amount" validate:"required"`
-}
-
-// Metafield is a struct representing a BigCommerce product metafield
-type Metafield struct {
-	ID            int64     `json:"id,omitempty"`
-	Key           string    `json:"key,omitempty"`
-	Value         string    `json:"value,omitempty"`
-	ResourceID    int64     `json:"resource_id,omitempty"`
-	ResourceType  string    `json:"resource_type,omitempty"`
-	Description   string    `json:"description,omitempty"`
-	DateCreated   time.Time `json:"date_created,omitempty"`
-	DateModified  time.Time `json:"date_modified,omitempty"`
-	Namespace     string    `json:"namespace,omitempty"`
-	PermissionSet string    `json:"permission_set,omitempty"`
+	ID          int64   `json:"id,omitempty"`
+	QuantityMin int     `json:"quantity_min" validate:"required"`
+	QuantityMax int     `json:"quantity_max" validate:"required"`
+	Type        string  `json:"type" validate:"required"`
+	Amount      Decimal `json:"amount" validate:"required"`
 }
 
 type CreateProductPayload struct {
@@ -169,10 +119,10 @@ type CreateProductPayload struct {
 	Width                    *float64          `json:"width,omitempty"`
 	Depth                    *float64          `json:"depth,omitempty"`
 	Height                   *float64          `json:"height,omitempty"`
-	Price                    *float64          `json:"price" validate:"required"`
-	CostPrice                *float64          `json:"cost_price,omitempty"`
-	RetailPrice              *float64          `json:"retail_price,omitempty"`
-	SalePrice                *float64          `json:"sale_price,omitempty"`
+	Price                    *Decimal          `json:"price" validate:"required"`
+	CostPrice                *Decimal          `json:"cost_price,omitempty"`
+	RetailPrice              *Decimal          `json:"retail_price,omitempty"`
+	SalePrice                *Decimal          `json:"sale_price,omitempty"`
 	InventoryLevel           *int              `json:"inventory_level,omitempty"`
 	InventoryWarning         *int              `json:"inventory_warning_level,omitempty"`
 	InventoryTracking        string            `json:"inventory_tracking,omitempty"`
@@ -221,36 +171,112 @@ type CreateProductPayload struct {
 	XeroPurchaseTaxType      *string           `json:"xero_purchase_tax_type,omitempty"`
 }
 
-// GetAllProducts gets all products from BigCommerce
-// args is a key-value map of additional arguments to pass to the API
+// UpdateProductPayload is a partial product update, nil fields are left unchanged
+// ID is required for UpdateProducts and ignored by UpdateProduct
+type UpdateProductPayload struct {
+	ID                       int64             `json:"id,omitempty"`
+	Name                     *string           `json:"name,omitempty"`
+	Type                     *string           `json:"type,omitempty"`
+	SKU                      *string           `json:"sku,omitempty"`
+	Description              *string           `json:"description,omitempty"`
+	Weight                   *float64          `json:"weight,omitempty"`
+	Width                    *float64          `json:"width,omitempty"`
+	Depth                    *float64          `json:"depth,omitempty"`
+	Height                   *float64          `json:"height,omitempty"`
+	Price                    *Decimal          `json:"price,omitempty"`
+	CostPrice                *Decimal          `json:"cost_price,omitempty"`
+	RetailPrice              *Decimal          `json:"retail_price,omitempty"`
+	SalePrice                *Decimal          `json:"sale_price,omitempty"`
+	MapPrice                 *Decimal          `json:"map_price,omitempty"`
+	FixedCostShippingPrice   *Decimal          `json:"fixed_cost_shipping_price,omitempty"`
+	IsFreeShipping           *bool             `json:"is_free_shipping,omitempty"`
+	InventoryLevel           *int              `json:"inventory_level,omitempty"`
+	InventoryWarning         *int              `json:"inventory_warning_level,omitempty"`
+	InventoryTracking        *string           `json:"inventory_tracking,omitempty"`
+	Availability             *string           `json:"availability,omitempty"`
+	AvailabilityDescription  *string           `json:"availability_description,omitempty"`
+	GiftWrappingOptionsType  *string           `json:"gift_wrapping_options_type,omitempty"`
+	GiftWrappingOptionsList  *[]int            `json:"gift_wrapping_options_list,omitempty"`
+	SortOrder                *int              `json:"sort_order,omitempty"`
+	Condition                *string           `json:"condition,omitempty"`
+	IsConditionShown         *bool             `json:"is_condition_shown,omitempty"`
+	Categories               *[]int            `json:"categories,omitempty"`
+	BrandID                  *int              `json:"brand_id,omitempty"`
+	MetaKeywords             *[]string         `json:"meta_keywords,omitempty"`
+	MetaDescription          *string           `json:"meta_description,omitempty"`
+	CustomFields             []CustomField     `json:"custom_fields,omitempty"`
+	BulkPricingRules         []BulkPricingRule `json:"bulk_pricing_rules,omitempty"`
+	UPC                      *string           `json:"upc,omitempty"`
+	MPN                      *string           `json:"mpn,omitempty"`
+	GTIN                     *string           `json:"gtin,omitempty"`
+	SearchKeywords           *string           `json:"search_keywords,omitempty"`
+	TaxClassID               *int              `json:"tax_class_id,omitempty"`
+	ProductTaxCode           *string           `json:"product_tax_code,omitempty"`
+	PreorderReleaseDate      *string           `json:"preorder_release_date,omitempty"`
+	PreorderMessage          *string           `json:"preorder_message,omitempty"`
+	IsPreorderOnly           *bool             `json:"is_preorder_only,omitempty"`
+	IsPriceHidden            *bool             `json:"is_price_hidden,omitempty"`
+	PriceHiddenLabel         *string           `json:"price_hidden_label,omitempty"`
+	OrderQuantityMinimum     *int              `json:"order_quantity_minimum,omitempty"`
+	OrderQuantityMaximum     *int              `json:"order_quantity_maximum,omitempty"`
+	PageTitle                *string           `json:"page_title,omitempty"`
+	IsVisible                *bool             `json:"is_visible,omitempty"`
+	IsFeatured               *bool             `json:"is_featured,omitempty"`
+	RelatedProducts          *[]int            `json:"related_products,omitempty"`
+	Warranty                 *string           `json:"warranty,omitempty"`
+	BinPickingNumber         *string           `json:"bin_picking_number,omitempty"`
+	LayoutFile               *string           `json:"layout_file,omitempty"`
+	UpSellingRelatedProducts *[]int            `json:"up_selling_related_products,omitempty"`
+}
+
+// maximum number of products in a batch update request
+const productBatchSize = 10
+
+// maximum number of ids in a batch delete request, to keep the URL short
+const deleteBatchSize = 250
+
+// SetProductFields sets the product fields (include_fields) the client requests
+// in GetProducts, GetAllProducts and GetProductByID, nil for all fields.
+// Call it before sharing the client between goroutines
+func (bc *Client) SetProductFields(fields []string) {
+	bc.productFields = append([]string(nil), fields...)
+}
+
+// SetProductInclude sets the product subresources (include) the client requests
+// in GetProducts, GetAllProducts and GetProductByID, e.g. variants, images, custom_fields,
+// bulk_pricing_rules, primary_image, modifiers, options, videos.
+// Call it before sharing the client between goroutines
+func (bc *Client) SetProductInclude(subresources []string) {
+	bc.productInclude = append([]string(nil), subresources...)
+}
+
+// productArgs returns args with the client's include_fields and include added, unless already set
+func (bc *Client) productArgs(args map[string]string) map[string]string {
+	ret := map[string]string{}
+	if len(bc.productFields) > 0 {
+		ret["include_fields"] = strings.Join(bc.productFields, ",")
+	}
+	if len(bc.productInclude) > 0 {
+		ret["include"] = strings.Join(bc.productInclude, ",")
+	}
+	for k, v := range args {
+		ret[k] = v
+	}
+	return ret
+}
+
+// CreateProduct creates a new product in BigCommerce and returns it
 func (bc *Client) CreateProduct(payload *CreateProductPayload) (*Product, error) {
 	var b []byte
 	b, _ = json.Marshal([]CreateProductPayload{*payload})
 	req := bc.getAPIRequest(http.MethodPost, "/v3/catalog/products", bytes.NewBuffer(b))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		if res.StatusCode == http.StatusUnprocessableEntity {
-			var errResp ErrorResult
-			err = json.Unmarshal(body, &errResp)
-			if err != nil {
-				log.Printf("Error: %s\nResult: %s", err, string(body))
-				return nil, err
-			}
-			if len(errResp.Errors) > 0 {
-				errors := []string{}
-				for _, e := range errResp.Errors {
-					errors = append(errors, e)
-				}
-				return nil, fmt.Errorf("%s", strings.Join(errors, ", "))
-			}
-			return nil, errors.New("unknown error")
-		}
-		log.Printf("Error: %s\nResult: %s", err, string(body))
 		return nil, err
 	}
 	var ret struct {
@@ -266,80 +292,48 @@ func (bc *Client) CreateProduct(payload *CreateProductPayload) (*Product, error)
 // GetAllProducts gets all products from BigCommerce
 // args is a key-value map of additional arguments to pass to the API
 func (bc *Client) GetAllProducts(args map[string]string) ([]Product, error) {
-	ps := []Product{}
-	var psp []Product
-	page := 1
-	more := true
-	var err error
-	retries := 0
-	for more {
-		psp, more, err = bc.GetProducts(args, page)
-		// log.Printf("page %d entries %d", page, len(psp))
-		if err != nil {
-			retries++
-			if retries > bc.MaxRetries {
-				log.Println("Max retries reached")
-				return ps, err
-			}
-			break
-		}
-		ps = append(ps, psp...)
-		page++
-	}
-	return ps, err
+	return bc.IterateProducts(args).All()
+}
+
+// GetAllProductsParallel gets all products from BigCommerce fetching up to workers pages at once,
+// for large catalogs. Products are returned in the same order as GetAllProducts
+// args is a key-value map of additional arguments to pass to the API
+func (bc *Client) GetAllProductsParallel(args map[string]string, workers int) ([]Product, error) {
+	return GetAllParallel[Product](bc, "/v3/catalog/products", bc.productArgs(args), workers)
+}
+
+// IterateProducts returns an iterator over all products, fetching them page by page
+// args is a key-value map of additional arguments to pass to the API
+func (bc *Client) IterateProducts(args map[string]string) *Iterator[Product] {
+	return NewIterator[Product](bc, "/v3/catalog/products", bc.productArgs(args))
 }
 
 // GetProducts gets a page of products from BigCommerce
 // args is a key-value map of additional arguments to pass to the API
 // page: the page number to download
 func (bc *Client) GetProducts(args map[string]string, page int) ([]Product, bool, error) {
-	fpart := ""
-	for k, v := range args {
-		fpart += "&" + k + "=" + v
-	}
-	url := "/v3/catalog/products?page=" + strconv.Itoa(page) + fpart
-	// log.Printf("GET %s", url)
-
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-	defer res.Body.Close()
-	if res.StatusCode == http.StatusNoContent {
-		return nil, false, ErrNoContent
-	}
-
-	body, err := ioutil.ReadAll(res.Body)
+	ps, p, err := getPage[Product](bc, "/v3/catalog/products", argsQuery(bc.productArgs(args)), page)
 	if err != nil {
 		return nil, false, err
 	}
-	var pp struct {
-		Status int       `json:"status"`
-		Title  string    `json:"title"`
-		Data   []Product `json:"data"`
-		Meta   struct {
-			Pagination Pagination `json:"pagination"`
-		} `json:"meta"`
-	}
-	err = json.Unmarshal(body, &pp)
-	if err != nil {
-		return nil, false, err
-	}
-	//	log.Printf("%d products (%+v)", len(pp.Data), pp.Meta.Pagination)
-
-	if pp.Status != 0 {
-		return nil, false, errors.New(pp.Title)
-	}
-	return pp.Data, pp.Meta.Pagination.CurrentPage < pp.Meta.Pagination.TotalPages, nil
+	return ps, p.CurrentPage < p.TotalPages, nil
 }
 
 // GetProductByID gets a product from BigCommerce by ID
 // productID: BigCommerce product ID to get
 func (bc *Client) GetProductByID(productID int64) (*Product, error) {
-	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "?include=variants,images,custom_fields,bulk_pricing_rules,primary_image,modifiers,options,videos"
+	q := url.Values{}
+	if len(bc.productInclude) > 0 {
+		q.Set("include", strings.Join(bc.productInclude, ","))
+	} else {
+		q.Set("include", strings.Join(productIncludeAll, ","))
+	}
+	if len(bc.productFields) > 0 {
+		q.Set("include_fields", strings.Join(bc.productFields, ","))
+	}
+	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "?" + encodeQuery(q)
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -362,30 +356,69 @@ func (bc *Client) GetProductByID(productID int64) (*Product, error) {
 
 // GetProductMetafields gets metafields values for a product
 // productID: BigCommerce product ID to get metafields for
+//
+// Deprecated: metafields of different namespaces with the same key overwrite each other in the map,
+// use GetMetafields(ProductMetafields(productID), nil)
 func (bc *Client) GetProductMetafields(productID int64) (map[string]Metafield, error) {
-	url := "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/metafields"
-	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	mfs, err := bc.GetMetafields(ProductMetafields(productID), nil)
 	if err != nil {
 		return nil, err
 	}
+	ret := map[string]Metafield{}
+	for _, mf := range mfs {
+		ret[mf.Key] = mf
+	}
+	return ret, nil
+}
 
-	defer res.Body.Close()
-	body, err := processBody(res)
+// UpdateProduct updates the given fields of a product and returns the updated product
+// productID: BigCommerce product ID to update
+func (bc *Client) UpdateProduct(productID int64, patch *UpdateProductPayload) (*Product, error) {
+	p := *patch
+	p.ID = 0
+	var ret Product
+	err := bc.sendData(http.MethodPut, "/v3/catalog/products/"+strconv.FormatInt(productID, 10), p, &ret)
 	if err != nil {
 		return nil, err
 	}
+	return &ret, nil
+}
 
-	var metafieldsResponse struct {
-		Metafields []Metafield `json:"data,omitempty"`
-	}
-	err = json.Unmarshal(body, &metafieldsResponse)
-	if err != nil {
-		return nil, err
+// UpdateProducts updates products in batches of 10, each payload must have an ID
+// returns the updated products. When a batch is rejected, its products are resent one by one
+// to find the failing ones, which are reported in a *BatchError, the others are still updated
+func (bc *Client) UpdateProducts(patches []UpdateProductPayload) ([]Product, error) {
+	for _, p := range patches {
+		if p.ID == 0 {
+			return nil, errors.New("product ID is required")
+		}
 	}
-	ret := map[string]Metafield{}
-	for _, mf := range metafieldsResponse.Metafields {
-		ret[mf.Key] = mf
+	return batchUpdate(patches, productBatchSize,
+		func(p UpdateProductPayload) int64 { return p.ID },
+		func(batch []UpdateProductPayload) ([]Product, error) {
+			var ret []Product
+			err := bc.sendData(http.MethodPut, "/v3/catalog/products", batch, &ret)
+			return ret, err
+		})
+}
+
+// DeleteProduct deletes a product
+// productID: BigCommerce product ID to delete
+func (bc *Client) DeleteProduct(productID int64) error {
+	return bc.sendJSON(http.MethodDelete, "/v3/catalog/products/"+strconv.FormatInt(productID, 10), nil, nil)
+}
+
+// DeleteProducts deletes products by ID using the id:in filter, in batches of 250
+func (bc *Client) DeleteProducts(productIDs []int64) error {
+	for start := 0; start < len(productIDs); start += deleteBatchSize {
+		end := start + deleteBatchSize
+		if end > len(productIDs) {
+			end = len(productIDs)
+		}
+		err := bc.sendJSON(http.MethodDelete, "/v3/catalog/products?id:in="+joinInts(productIDs[start:end]), nil, nil)
+		if err != nil {
+			return err
+		}
 	}
-	return ret, nil
+	return nil
 }
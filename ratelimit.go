@@ -0,0 +1,88 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitStatus is the per-store API quota as reported by the latest X-Rate-Limit-* response headers
+type RateLimitStatus struct {
+	RequestsQuota int           // X-Rate-Limit-Requests-Quota: requests allowed per window
+	RequestsLeft  int           // X-Rate-Limit-Requests-Left: requests left in the current window
+	TimeWindow    time.Duration // X-Rate-Limit-Time-Window-Ms: length of the window
+	TimeReset     time.Duration // X-Rate-Limit-Time-Reset-Ms: time until the window resets, as of UpdatedAt
+	UpdatedAt     time.Time     // when the headers were received, zero if no request was made yet
+}
+
+// ResetAt returns the time when the current quota window resets
+func (s RateLimitStatus) ResetAt() time.Time {
+	return s.UpdatedAt.Add(s.TimeReset)
+}
+
+// rateLimiter tracks the store quota, it is shared by all copies of a Client
+type rateLimiter struct {
+	mu     sync.Mutex
+	status RateLimitStatus
+}
+
+// rateLimitInit guards the lazy creation of the rate limiter of clients built as struct literals
+var rateLimitInit sync.Mutex
+
+// limiter returns the client's rate limiter, creating it if the client was not built by NewClient
+func (bc *Client) limiter() *rateLimiter {
+	rateLimitInit.Lock()
+	defer rateLimitInit.Unlock()
+	if bc.rateLimit == nil {
+		bc.rateLimit = &rateLimiter{}
+	}
+	return bc.rateLimit
+}
+
+// RateLimitStatus returns the last known API quota for the client's store
+func (bc *Client) RateLimitStatus() RateLimitStatus {
+	rl := bc.limiter()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.status
+}
+
+// update stores the quota from the response headers, if present
+func (rl *rateLimiter) update(h http.Header) {
+	if rl == nil || h.Get("X-Rate-Limit-Requests-Left") == "" {
+		return
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.status = RateLimitStatus{
+		RequestsQuota: headerInt(h, "X-Rate-Limit-Requests-Quota"),
+		RequestsLeft:  headerInt(h, "X-Rate-Limit-Requests-Left"),
+		TimeWindow:    time.Duration(headerInt(h, "X-Rate-Limit-Time-Window-Ms")) * time.Millisecond,
+		TimeReset:     time.Duration(headerInt(h, "X-Rate-Limit-Time-Reset-Ms")) * time.Millisecond,
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// delay returns how long to pause before the next request
+// so that no more than reserve requests are left unused in the window
+func (rl *rateLimiter) delay(reserve int) time.Duration {
+	if rl == nil {
+		return 0
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.status.UpdatedAt.IsZero() || rl.status.RequestsLeft > reserve {
+		return 0
+	}
+	d := time.Until(rl.status.ResetAt())
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func headerInt(h http.Header, key string) int {
+	i, _ := strconv.Atoi(h.Get(key))
+	return i
+}
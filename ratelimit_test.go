@@ -0,0 +1,31 @@
+package bigcommerce
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRateLimitStatusOfStructLiteralClient(t *testing.T) {
+	bc := &Client{
+		StoreHash:  "store",
+		XAuthToken: "token",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			h := http.Header{}
+			h.Set("X-Rate-Limit-Requests-Quota", "150")
+			h.Set("X-Rate-Limit-Requests-Left", "42")
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Header: h}, nil
+		})},
+	}
+	c := bc.WithContext(context.Background())
+	if err := c.sendJSON(http.MethodGet, "/v3/catalog/summary", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, client := range []*Client{c, bc} {
+		if s := client.RateLimitStatus(); s.RequestsLeft != 42 || s.RequestsQuota != 150 {
+			t.Errorf("RateLimitStatus() = %+v, want 42 of 150 left", s)
+		}
+	}
+}
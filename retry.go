@@ -0,0 +1,230 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// number of times a request is resent after a 429 Too Many Requests response,
+// counted separately from Client.MaxRetries since the wait time is given by BigCommerce
+const rateLimitRetries = 3
+
+var errNotRewindable = errors.New("request body can't be resent")
+
+// RetryPolicy decides which failed requests are resent and how long to wait between attempts.
+// The number of retries is set by Client.MaxRetries
+type RetryPolicy struct {
+	// MinBackoff is the wait before the first retry, doubled for every next one
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+	// StatusCodes are the HTTP response codes that are retried
+	StatusCodes []int
+	// RetryNonIdempotent allows resending POST and PATCH requests after a timeout or a 5xx response.
+	// Off by default, as BigCommerce may have already processed them (e.g. CreateCart or CreateProduct)
+	// and resending would create duplicates.
+	// Requests rejected with 429 or refused before reaching the server are always safe to resend.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is used by clients that have no RetryPolicy set
+var DefaultRetryPolicy = RetryPolicy{
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 10 * time.Second,
+	StatusCodes: []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+func (bc *Client) retryPolicy() *RetryPolicy {
+	if bc.RetryPolicy != nil {
+		return bc.RetryPolicy
+	}
+	return &DefaultRetryPolicy
+}
+
+// backoff returns the wait before the given retry (starting at 1): exponential, with jitter
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// between half and full backoff, so parallel clients don't retry in lockstep
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryStatus tells if a response with the given status code should be retried
+func (p *RetryPolicy) retryStatus(req *http.Request, code int) bool {
+	for _, c := range p.StatusCodes {
+		if c == code {
+			return code == http.StatusTooManyRequests || p.RetryNonIdempotent || isIdempotent(req.Method)
+		}
+	}
+	return false
+}
+
+// retryError tells if a request that failed with a transport error should be retried
+func (p *RetryPolicy) retryError(req *http.Request, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		// never reached the server
+		return true
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// do sends the request, pausing when the store quota is about to run out
+// and resending it according to the client's RetryPolicy on transient failures
+func (bc *Client) do(req *http.Request) (*http.Response, error) {
+	policy := bc.retryPolicy()
+	log := bc.logger()
+	rl := bc.limiter()
+	start := time.Now()
+	retries, limited := 0, 0
+	for attempt := 1; ; attempt++ {
+		if err := sleepContext(req.Context(), rl.delay(bc.RateLimitReserve)); err != nil {
+			return nil, err
+		}
+		res, err := bc.HTTPClient.Do(req)
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if retries >= bc.MaxRetries || !policy.retryError(req, err) {
+				log.Error("bigcommerce request error", "method", req.Method, "path", apiPath(req),
+					"duration", time.Since(start), "attempts", attempt, "error", logError(err))
+				return nil, err
+			}
+			retries++
+			wait = policy.backoff(retries)
+		case !policy.retryStatus(req, res.StatusCode):
+			rl.update(res.Header)
+			return bc.logDone(req, res, start, attempt), nil
+		case res.StatusCode == http.StatusTooManyRequests:
+			rl.update(res.Header)
+			if limited >= rateLimitRetries {
+				return bc.logDone(req, res, start, attempt), nil
+			}
+			limited++
+			wait = time.Duration(headerInt(res.Header, "X-Rate-Limit-Time-Reset-Ms")) * time.Millisecond
+			if wait <= 0 {
+				wait = policy.backoff(limited)
+			}
+		default:
+			rl.update(res.Header)
+			if retries >= bc.MaxRetries {
+				return bc.logDone(req, res, start, attempt), nil
+			}
+			retries++
+			wait = retryAfter(res.Header)
+			if wait <= 0 {
+				wait = policy.backoff(retries)
+			}
+		}
+		next, rerr := rewindRequest(req)
+		if rerr != nil {
+			if res != nil {
+				return bc.logDone(req, res, start, attempt), nil
+			}
+			return nil, err
+		}
+		fields := []interface{}{"method", req.Method, "path", apiPath(req), "attempt", attempt, "wait", wait}
+		if res != nil {
+			fields = append(fields, "status", res.StatusCode, "request_id", res.Header.Get("X-Request-Id"))
+			drainBody(res)
+		} else {
+			fields = append(fields, "error", logError(err))
+		}
+		log.Info("retrying bigcommerce request", fields...)
+		if err = sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		req = next
+	}
+}
+
+// logDone logs the final response of a request and returns it
+func (bc *Client) logDone(req *http.Request, res *http.Response, start time.Time, attempts int) *http.Response {
+	log := bc.logger()
+	if bc.LogBodies {
+		logBodies(log, req, res)
+	}
+	logResponse(log, req, res, start, attempts)
+	return res
+}
+
+// rewindRequest returns a copy of req with a fresh body so it can be sent again
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, errNotRewindable
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	next := req.Clone(req.Context())
+	next.Body = body
+	return next, nil
+}
+
+// drainBody reads the rest of the body so the connection can be reused, and closes it
+func drainBody(res *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func retryAfter(h http.Header) time.Duration {
+	s, _ := strconv.Atoi(h.Get("Retry-After"))
+	return time.Duration(s) * time.Second
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"time"
 )
@@ -34,7 +33,7 @@ func (bc *Client) CreateScript(s *Script) (*Script, error) {
 		return nil, err
 	}
 	req := bc.getAPIRequest(http.MethodPost, "/v3/content/scripts", bytes.NewReader(sJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return s, err
 	}
@@ -42,7 +41,7 @@ func (bc *Client) CreateScript(s *Script) (*Script, error) {
 	var sRes struct {
 		Data Script `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return s, err
 	}
@@ -55,7 +54,7 @@ func (bc *Client) CreateScript(s *Script) (*Script, error) {
 
 func (bc *Client) GetScriptByID(uuid string) (*Script, error) {
 	req := bc.getAPIRequest(http.MethodGet, fmt.Sprintf("/v3/content/scripts/%s", uuid), nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +62,7 @@ func (bc *Client) GetScriptByID(uuid string) (*Script, error) {
 	var sRes struct {
 		Data Script `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +75,7 @@ func (bc *Client) GetScriptByID(uuid string) (*Script, error) {
 
 func (bc *Client) GetScripts() ([]Script, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/content/scripts", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +83,7 @@ func (bc *Client) GetScripts() ([]Script, error) {
 	var sRes struct {
 		Data []Script `json:"data"`
 	}
-	b, err := ioutil.ReadAll(res.Body)
+	b, err := processBody(res)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,135 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ErrNothingToShip is returned by FulfillOrderAddress when all items of the address are shipped
+var ErrNothingToShip = errors.New("nothing left to ship")
+
+// Shipment is a v2 order shipment
+type Shipment struct {
+	ID                          int64          `json:"id"`
+	OrderID                     int64          `json:"order_id"`
+	CustomerID                  int64          `json:"customer_id"`
+	OrderAddressID              int64          `json:"order_address_id"`
+	DateCreated                 string         `json:"date_created"`
+	TrackingNumber              string         `json:"tracking_number"`
+	MerchantShippingCost        Decimal        `json:"merchant_shipping_cost"`
+	ShippingMethod              string         `json:"shipping_method"`
+	Comments                    string         `json:"comments"`
+	ShippingProvider            string         `json:"shipping_provider"`
+	ShippingProviderDisplayName string         `json:"shipping_provider_display_name"`
+	TrackingCarrier             string         `json:"tracking_carrier"`
+	TrackingLink                string         `json:"tracking_link"`
+	GeneratedTrackingLink       string         `json:"generated_tracking_link"`
+	BillingAddress              OrderAddress   `json:"billing_address"`
+	ShippingAddress             OrderAddress   `json:"shipping_address"`
+	Items                       []ShipmentItem `json:"items"`
+}
+
+// ShipmentItem is a shipped quantity of an order product, OrderProductID is OrderProduct.ID
+type ShipmentItem struct {
+	OrderProductID int64 `json:"order_product_id"`
+	ProductID      int64 `json:"product_id,omitempty"`
+	Quantity       int   `json:"quantity"`
+}
+
+// ShipmentPayload is the body of CreateShipment and UpdateShipment, only the set fields are sent.
+// CreateShipment requires OrderAddressID and Items
+type ShipmentPayload struct {
+	OrderAddressID   int64          `json:"order_address_id,omitempty"`
+	TrackingNumber   string         `json:"tracking_number,omitempty"`
+	ShippingMethod   string         `json:"shipping_method,omitempty"`
+	ShippingProvider string         `json:"shipping_provider,omitempty"` // e.g. ups, fedex, usps, or empty for custom
+	TrackingCarrier  string         `json:"tracking_carrier,omitempty"`  // e.g. dhl, royal-mail
+	TrackingLink     string         `json:"tracking_link,omitempty"`
+	Comments         string         `json:"comments,omitempty"`
+	Items            []ShipmentItem `json:"items,omitempty"`
+}
+
+func shipmentsPath(orderID int64) string {
+	return "/v2/orders/" + strconv.FormatInt(orderID, 10) + "/shipments"
+}
+
+// GetShipments returns all shipments of an order
+func (bc *Client) GetShipments(orderID int64) ([]Shipment, error) {
+	return newV2Iterator[Shipment](bc, shipmentsPath(orderID), url.Values{}).Limit(250).All()
+}
+
+// GetShipment returns a shipment of an order by ID
+func (bc *Client) GetShipment(orderID, shipmentID int64) (*Shipment, error) {
+	var ret Shipment
+	err := bc.sendJSON(http.MethodGet, shipmentsPath(orderID)+"/"+strconv.FormatInt(shipmentID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateShipment ships items of an order to one of its shipping addresses,
+// the order status is updated to Shipped or Partially Shipped
+func (bc *Client) CreateShipment(orderID int64, payload *ShipmentPayload) (*Shipment, error) {
+	if payload.OrderAddressID == 0 || len(payload.Items) == 0 {
+		return nil, errors.New("shipment order address ID and items are required")
+	}
+	var ret Shipment
+	err := bc.sendJSON(http.MethodPost, shipmentsPath(orderID), payload, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateShipment updates the given fields of a shipment, e.g. to add the tracking number
+func (bc *Client) UpdateShipment(orderID, shipmentID int64, patch *ShipmentPayload) (*Shipment, error) {
+	var ret Shipment
+	err := bc.sendJSON(http.MethodPut, shipmentsPath(orderID)+"/"+strconv.FormatInt(shipmentID, 10), patch, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteShipment deletes a shipment of an order
+func (bc *Client) DeleteShipment(orderID, shipmentID int64) error {
+	return bc.sendJSON(http.MethodDelete, shipmentsPath(orderID)+"/"+strconv.FormatInt(shipmentID, 10), nil, nil)
+}
+
+// FulfillOrderAddress ships all the not yet shipped (nor refunded) quantities of the products
+// going to an order address in one shipment, with the tracking details of shipment (may be nil)
+// returns ErrNothingToShip if everything was already shipped
+func (bc *Client) FulfillOrderAddress(orderID, orderAddressID int64, shipment *ShipmentPayload) (*Shipment, error) {
+	products, err := bc.GetOrderProducts(orderID)
+	if err != nil {
+		return nil, err
+	}
+	payload := ShipmentPayload{}
+	if shipment != nil {
+		payload = *shipment
+	}
+	payload.OrderAddressID = orderAddressID
+	payload.Items = remainingShipmentItems(products, orderAddressID)
+	if len(payload.Items) == 0 {
+		return nil, ErrNothingToShip
+	}
+	return bc.CreateShipment(orderID, &payload)
+}
+
+// remainingShipmentItems returns the quantities of the products of an order address left to ship,
+// bundled products ship with their parent
+func remainingShipmentItems(products []OrderProduct, orderAddressID int64) []ShipmentItem {
+	var items []ShipmentItem
+	for _, p := range products {
+		if p.OrderAddressID != orderAddressID || p.IsBundledProduct {
+			continue
+		}
+		if left := p.Quantity - p.QuantityShipped - p.QuantityRefunded; left > 0 {
+			items = append(items, ShipmentItem{OrderProductID: p.ID, Quantity: left})
+		}
+	}
+	return items
+}
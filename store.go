@@ -65,7 +65,7 @@ type StoreInfo struct {
 func (bc *Client) GetStoreInfo() (StoreInfo, error) {
 	var storeInfo StoreInfo
 	req := bc.getAPIRequest(http.MethodGet, "/v2/store", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return storeInfo, err
 	}
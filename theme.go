@@ -353,7 +353,7 @@ func (bc *Client) GetActiveThemeConfig() (*ThemeConfig, error) {
 // GetThemes returns a list of all store themes
 func (bc *Client) GetThemes() ([]Theme, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/themes", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +375,7 @@ func (bc *Client) GetThemes() ([]Theme, error) {
 // GetThemeConfig returns the configuration for a specific theme by theme UUID
 func (bc *Client) GetThemeConfig(uuid string) (*ThemeConfig, error) {
 	req := bc.getAPIRequest(http.MethodGet, "/v3/themes/"+uuid+"/configurations", nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,168 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// CatalogTree is a category tree, each channel (storefront) is assigned one tree
+type CatalogTree struct {
+	ID       int64   `json:"id,omitempty"`
+	Name     string  `json:"name"`
+	Channels []int64 `json:"channels"`
+}
+
+// TreeCategory is a category of the /v3/catalog/trees/categories endpoint
+type TreeCategory struct {
+	CategoryID         int64    `json:"category_id"`
+	CategoryUUID       string   `json:"category_uuid,omitempty"`
+	ParentID           int64    `json:"parent_id"`
+	TreeID             int64    `json:"tree_id"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description,omitempty"`
+	Views              int      `json:"views,omitempty"`
+	SortOrder          int      `json:"sort_order"`
+	PageTitle          string   `json:"page_title,omitempty"`
+	SearchKeywords     string   `json:"search_keywords,omitempty"`
+	MetaKeywords       []string `json:"meta_keywords,omitempty"`
+	MetaDescription    string   `json:"meta_description,omitempty"`
+	LayoutFile         string   `json:"layout_file,omitempty"`
+	IsVisible          bool     `json:"is_visible"`
+	DefaultProductSort string   `json:"default_product_sort,omitempty"`
+	ImageURL           string   `json:"image_url,omitempty"`
+	URL                struct {
+		Path         string `json:"path"`
+		IsCustomized bool   `json:"is_customized"`
+	} `json:"url"`
+}
+
+// TreeCategoryPayload is an item of CreateTreeCategories and UpdateTreeCategories,
+// CategoryID is required to update, TreeID and Name to create
+type TreeCategoryPayload struct {
+	CategoryID int64 `json:"category_id,omitempty"`
+	TreeID     int64 `json:"tree_id,omitempty"`
+	CategoryPayload
+}
+
+// categoryTreeNode is a node of the nested /v3/catalog/trees/{id}/categories response
+type categoryTreeNode struct {
+	ID        int64              `json:"id"`
+	ParentID  int64              `json:"parent_id"`
+	Name      string             `json:"name"`
+	IsVisible bool               `json:"is_visible"`
+	URL       string             `json:"url"`
+	Children  []categoryTreeNode `json:"children"`
+}
+
+// treeCategoriesBatchSize is the maximum number of categories per trees/categories request
+const treeCategoriesBatchSize = 50
+
+// GetCatalogTrees returns the category trees, of the given channels only if any
+func (bc *Client) GetCatalogTrees(channelIDs ...int64) ([]CatalogTree, error) {
+	args := map[string]string{}
+	setInts(args, "channel_id:in", channelIDs)
+	return NewIterator[CatalogTree](bc, "/v3/catalog/trees", args).All()
+}
+
+// UpsertCatalogTrees creates the trees without ID and updates the others
+func (bc *Client) UpsertCatalogTrees(trees []CatalogTree) ([]CatalogTree, error) {
+	var ret []CatalogTree
+	err := bc.sendData(http.MethodPut, "/v3/catalog/trees", trees, &ret)
+	return ret, err
+}
+
+// DeleteCatalogTrees deletes category trees by ID, along with their categories
+func (bc *Client) DeleteCatalogTrees(treeIDs []int64) error {
+	if len(treeIDs) == 0 {
+		return nil
+	}
+	return bc.sendJSON(http.MethodDelete, "/v3/catalog/trees?id:in="+joinInts(treeIDs), nil, nil)
+}
+
+// GetCategoryTree downloads a category tree and returns it as a CategoryTree
+func (bc *Client) GetCategoryTree(treeID int64) (*CategoryTree, error) {
+	var nodes []categoryTreeNode
+	err := bc.sendData(http.MethodGet, "/v3/catalog/trees/"+strconv.FormatInt(treeID, 10)+"/categories", nil, &nodes)
+	if err != nil {
+		return nil, err
+	}
+	var cats []Category
+	var flatten func(nodes []categoryTreeNode)
+	flatten = func(nodes []categoryTreeNode) {
+		for i, n := range nodes {
+			c := Category{ID: n.ID, Name: n.Name, ParentID: n.ParentID, Visible: n.IsVisible, SortOrder: i, TreeID: treeID, URL: n.URL}
+			c.CustomURL.URL = n.URL
+			cats = append(cats, c)
+			flatten(n.Children)
+		}
+	}
+	flatten(nodes)
+	return NewCategoryTree(cats)
+}
+
+// GetChannelCategoryTree returns the category tree assigned to a channel
+func (bc *Client) GetChannelCategoryTree(channelID int64) (*CategoryTree, error) {
+	trees, err := bc.GetCatalogTrees(channelID)
+	if err != nil {
+		return nil, err
+	}
+	if len(trees) == 0 {
+		return nil, fmt.Errorf("no category tree for channel %d: %w", channelID, ErrNotFound)
+	}
+	return bc.GetCategoryTree(trees[0].ID)
+}
+
+// GetTreeCategories returns the categories of all trees as a flat list
+// args is a map of arguments to pass to the API, e.g. tree_id:in or category_id:in
+func (bc *Client) GetTreeCategories(args map[string]string) ([]TreeCategory, error) {
+	return NewIterator[TreeCategory](bc, "/v3/catalog/trees/categories", args).All()
+}
+
+// CreateTreeCategories creates categories in the given trees, in batches of 50
+// failing categories are reported in a *BatchError with their index in cats as ID, the others are still created
+func (bc *Client) CreateTreeCategories(cats []TreeCategoryPayload) ([]TreeCategory, error) {
+	for _, c := range cats {
+		if c.TreeID == 0 || c.Name == nil || *c.Name == "" {
+			return nil, errors.New("tree ID and category name are required")
+		}
+	}
+	return batchUpdateByIndex(cats, treeCategoriesBatchSize,
+		func(batch []TreeCategoryPayload) ([]TreeCategory, error) {
+			var ret []TreeCategory
+			err := bc.sendData(http.MethodPost, "/v3/catalog/trees/categories", batch, &ret)
+			return ret, err
+		})
+}
+
+// UpdateTreeCategories updates categories of any tree, in batches of 50
+func (bc *Client) UpdateTreeCategories(cats []TreeCategoryPayload) ([]TreeCategory, error) {
+	for _, c := range cats {
+		if c.CategoryID == 0 {
+			return nil, errors.New("category ID is required")
+		}
+	}
+	return batchUpdate(cats, treeCategoriesBatchSize,
+		func(c TreeCategoryPayload) int64 { return c.CategoryID },
+		func(batch []TreeCategoryPayload) ([]TreeCategory, error) {
+			var ret []TreeCategory
+			err := bc.sendData(http.MethodPut, "/v3/catalog/trees/categories", batch, &ret)
+			return ret, err
+		})
+}
+
+// DeleteTreeCategories deletes categories of any tree by ID
+func (bc *Client) DeleteTreeCategories(categoryIDs []int64) error {
+	for start := 0; start < len(categoryIDs); start += deleteBatchSize {
+		end := start + deleteBatchSize
+		if end > len(categoryIDs) {
+			end = len(categoryIDs)
+		}
+		err := bc.sendJSON(http.MethodDelete, "/v3/catalog/trees/categories?category_id:in="+joinInts(categoryIDs[start:end]), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -64,12 +64,12 @@ type CartCoupon struct {
 	Code             string      `json:"code"`
 	ID               interface{} `json:"id"`
 	CouponType       string      `json:"coupon_type"`
-	DiscountedAmount float64     `json:"discounted_amount"`
+	DiscountedAmount Decimal     `json:"discounted_amount"`
 }
 
 type Discount struct {
 	ID               interface{} `json:"id"`
-	DiscountedAmount float64     `json:"discounted_amount"`
+	DiscountedAmount Decimal     `json:"discounted_amount"`
 }
 
 type ErrorResult struct {
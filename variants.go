@@ -0,0 +1,156 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// variantBatchSize is the maximum number of variants per store-wide batch update
+const variantBatchSize = 50
+
+// Variant is a product variant, a purchasable combination of option values
+type Variant struct {
+	ID                        int64         `json:"id,omitempty"`
+	ProductID                 int64         `json:"product_id,omitempty"`
+	Sku                       string        `json:"sku,omitempty"`
+	SkuID                     int64         `json:"sku_id,omitempty"`
+	Price                     Decimal       `json:"price"`
+	CalculatedPrice           Decimal       `json:"calculated_price"`
+	SalePrice                 Decimal       `json:"sale_price"`
+	RetailPrice               Decimal       `json:"retail_price"`
+	MapPrice                  Decimal       `json:"map_price"`
+	Weight                    float64       `json:"weight,omitempty"`
+	Width                     float64       `json:"width,omitempty"`
+	Height                    float64       `json:"height,omitempty"`
+	Depth                     float64       `json:"depth,omitempty"`
+	IsFreeShipping            bool          `json:"is_free_shipping,omitempty"`
+	FixedCostShippingPrice    Decimal       `json:"fixed_cost_shipping_price"`
+	CalculatedWeight          float64       `json:"calculated_weight,omitempty"`
+	PurchasingDisabled        bool          `json:"purchasing_disabled,omitempty"`
+	PurchasingDisabledMessage string        `json:"purchasing_disabled_message,omitempty"`
+	ImageURL                  string        `json:"image_url,omitempty"`
+	CostPrice                 Decimal       `json:"cost_price"`
+	Upc                       string        `json:"upc,omitempty"`
+	Mpn                       string        `json:"mpn,omitempty"`
+	Gtin                      string        `json:"gtin,omitempty"`
+	InventoryLevel            int           `json:"inventory_level,omitempty"`
+	InventoryWarningLevel     int           `json:"inventory_warning_level,omitempty"`
+	BinPickingNumber          string        `json:"bin_picking_number,omitempty"`
+	OptionValues              []OptionValue `json:"option_values,omitempty"`
+}
+
+// VariantPayload is the body of CreateVariant, UpdateVariant and UpdateVariants,
+// only the non-nil fields are sent. ID is required by UpdateVariants only,
+// OptionValues (option_id and id) are required by CreateVariant only
+type VariantPayload struct {
+	ID                        int64         `json:"id,omitempty"`
+	ProductID                 int64         `json:"product_id,omitempty"`
+	Sku                       *string       `json:"sku,omitempty"`
+	Price                     *Decimal      `json:"price,omitempty"`
+	SalePrice                 *Decimal      `json:"sale_price,omitempty"`
+	RetailPrice               *Decimal      `json:"retail_price,omitempty"`
+	MapPrice                  *Decimal      `json:"map_price,omitempty"`
+	CostPrice                 *Decimal      `json:"cost_price,omitempty"`
+	Weight                    *float64      `json:"weight,omitempty"`
+	Width                     *float64      `json:"width,omitempty"`
+	Height                    *float64      `json:"height,omitempty"`
+	Depth                     *float64      `json:"depth,omitempty"`
+	IsFreeShipping            *bool         `json:"is_free_shipping,omitempty"`
+	FixedCostShippingPrice    *Decimal      `json:"fixed_cost_shipping_price,omitempty"`
+	PurchasingDisabled        *bool         `json:"purchasing_disabled,omitempty"`
+	PurchasingDisabledMessage *string       `json:"purchasing_disabled_message,omitempty"`
+	ImageURL                  *string       `json:"image_url,omitempty"`
+	Upc                       *string       `json:"upc,omitempty"`
+	Mpn                       *string       `json:"mpn,omitempty"`
+	Gtin                      *string       `json:"gtin,omitempty"`
+	InventoryLevel            *int          `json:"inventory_level,omitempty"`
+	InventoryWarningLevel     *int          `json:"inventory_warning_level,omitempty"`
+	BinPickingNumber          *string       `json:"bin_picking_number,omitempty"`
+	OptionValues              []OptionValue `json:"option_values,omitempty"`
+}
+
+func variantsPath(productID int64) string {
+	return "/v3/catalog/products/" + strconv.FormatInt(productID, 10) + "/variants"
+}
+
+// GetAllVariants gets all variants of a product
+// productID: BigCommerce product ID
+// args is a key-value map of additional arguments to pass to the API
+func (bc *Client) GetAllVariants(productID int64, args map[string]string) ([]Variant, error) {
+	return bc.IterateVariants(productID, args).All()
+}
+
+// IterateVariants returns an iterator over the variants of a product, fetching them page by page
+// productID: BigCommerce product ID
+// args is a key-value map of additional arguments to pass to the API
+func (bc *Client) IterateVariants(productID int64, args map[string]string) *Iterator[Variant] {
+	return NewIterator[Variant](bc, variantsPath(productID), args)
+}
+
+// GetVariants gets a page of variants of a product
+// productID: BigCommerce product ID
+// args is a key-value map of additional arguments to pass to the API
+// page: the page number to download
+func (bc *Client) GetVariants(productID int64, args map[string]string, page int) ([]Variant, bool, error) {
+	vs, p, err := getPage[Variant](bc, variantsPath(productID), argsQuery(args), page)
+	if err != nil {
+		return nil, false, err
+	}
+	return vs, p.CurrentPage < p.TotalPages, nil
+}
+
+// GetVariant gets a variant of a product by ID
+func (bc *Client) GetVariant(productID, variantID int64) (*Variant, error) {
+	var ret Variant
+	err := bc.sendData(http.MethodGet, variantsPath(productID)+"/"+strconv.FormatInt(variantID, 10), nil, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// CreateVariant creates a variant of a product, payload.OptionValues must reference existing option values
+func (bc *Client) CreateVariant(productID int64, payload *VariantPayload) (*Variant, error) {
+	var ret Variant
+	err := bc.sendData(http.MethodPost, variantsPath(productID), payload, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// UpdateVariant updates the given fields of a variant and returns the updated variant
+func (bc *Client) UpdateVariant(productID, variantID int64, patch *VariantPayload) (*Variant, error) {
+	p := *patch
+	p.ID = 0
+	var ret Variant
+	err := bc.sendData(http.MethodPut, variantsPath(productID)+"/"+strconv.FormatInt(variantID, 10), p, &ret)
+	if err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// DeleteVariant deletes a variant of a product
+func (bc *Client) DeleteVariant(productID, variantID int64) error {
+	return bc.sendJSON(http.MethodDelete, variantsPath(productID)+"/"+strconv.FormatInt(variantID, 10), nil, nil)
+}
+
+// UpdateVariants updates variants of any products in batches of 50, each payload must have an ID
+// returns the updated variants. When a batch is rejected, its variants are resent one by one
+// to find the failing ones, which are reported in a *BatchError, the others are still updated
+func (bc *Client) UpdateVariants(patches []VariantPayload) ([]Variant, error) {
+	for _, p := range patches {
+		if p.ID == 0 {
+			return nil, errors.New("variant ID is required")
+		}
+	}
+	return batchUpdate(patches, variantBatchSize,
+		func(p VariantPayload) int64 { return p.ID },
+		func(batch []VariantPayload) ([]Variant, error) {
+			var ret []Variant
+			err := bc.sendData(http.MethodPut, "/v3/catalog/variants", batch, &ret)
+			return ret, err
+		})
+}
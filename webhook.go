@@ -3,7 +3,6 @@ package bigcommerce
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
@@ -77,7 +76,7 @@ func (bc *Client) GetWebhooks() ([]Webhook, error) {
 	url := "/v3/hooks?limit=250"
 
 	req := bc.getAPIRequest(http.MethodGet, url, nil)
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -115,14 +114,14 @@ func (bc *Client) CreateWebhook(scope, destination string, headers map[string]st
 				return webhook.ID, nil
 			}
 			req := bc.getAPIRequest(http.MethodPut, url+"/"+strconv.FormatInt(webhook.ID, 10), strings.NewReader(`{"is_active": true}`))
-			res, err := bc.HTTPClient.Do(req)
+			res, err := bc.do(req)
 			if err != nil {
 				return 0, err
 			}
 			defer res.Body.Close()
-			body, err := processBody(res)
+			_, err = processBody(res)
 			if err != nil {
-				return 0, fmt.Errorf("error processing response body: %v %s", err, string(body))
+				return 0, err
 			}
 			return webhook.ID, nil
 		}
@@ -142,7 +141,7 @@ func (bc *Client) CreateWebhook(scope, destination string, headers map[string]st
 	reqJSON, _ := json.Marshal(payload)
 
 	req := bc.getAPIRequest(http.MethodPost, url, bytes.NewReader(reqJSON))
-	res, err := bc.HTTPClient.Do(req)
+	res, err := bc.do(req)
 	if err != nil {
 		return 0, err
 	}
@@ -150,7 +149,7 @@ func (bc *Client) CreateWebhook(scope, destination string, headers map[string]st
 	defer res.Body.Close()
 	body, err := processBody(res)
 	if err != nil {
-		return 0, fmt.Errorf("error processing response body: %v %s (%s)", err, string(body), string(reqJSON))
+		return 0, err
 	}
 	var respWebhook Webhook
 	err = json.Unmarshal(body, &respWebhook)